@@ -0,0 +1,30 @@
+terraform {
+  required_providers {
+    strapi = {
+      source  = "fbritoferreira/strapi"
+      version = "0.1.0"
+    }
+  }
+}
+
+provider "strapi" {
+  endpoint       = "http://localhost:1337"
+  admin_email    = "admin@example.com"
+  admin_password = "your-admin-password-here"
+}
+
+data "strapi_admin_roles" "all" {}
+
+# Role codes are stable across environments, unlike role IDs
+locals {
+  admin_role_ids = {
+    for role in data.strapi_admin_roles.all.roles : role.code => tonumber(role.id)
+  }
+}
+
+resource "strapi_admin_user" "editor" {
+  email     = "editor@example.com"
+  firstname = "Jane"
+  lastname  = "Smith"
+  roles     = [local.admin_role_ids["strapi-editor"]]
+}
@@ -0,0 +1,31 @@
+terraform {
+  required_providers {
+    strapi = {
+      source  = "fbritoferreira/strapi"
+      version = "0.1.0"
+    }
+  }
+}
+
+provider "strapi" {
+  endpoint       = "http://localhost:1337"
+  admin_email    = "admin@example.com"
+  admin_password = "your-admin-password-here"
+}
+
+# Look up an administrator created through the admin panel
+data "strapi_admin_user" "owner" {
+  email = "owner@example.com"
+}
+
+# Give a new administrator the same roles
+resource "strapi_admin_user" "deputy" {
+  email     = "deputy@example.com"
+  firstname = "Deputy"
+  lastname  = "Owner"
+  roles     = data.strapi_admin_user.owner.roles
+}
+
+output "owner_role_codes" {
+  value = data.strapi_admin_user.owner.role_codes
+}
@@ -0,0 +1,41 @@
+terraform {
+  required_providers {
+    strapi = {
+      source  = "fbritoferreira/strapi"
+      version = "0.1.0"
+    }
+  }
+}
+
+provider "strapi" {
+  endpoint  = "http://localhost:1337"
+  api_token = "your-api-token-here"
+}
+
+# Look up the built-in roles by type
+data "strapi_role" "authenticated" {
+  type = "authenticated"
+}
+
+data "strapi_role" "public" {
+  type = "public"
+}
+
+# Look up a custom role by name
+data "strapi_role" "editor" {
+  name = "Editor"
+}
+
+resource "strapi_user" "reader" {
+  username = "reader"
+  email    = "reader@example.com"
+  role_id  = tonumber(data.strapi_role.authenticated.id)
+}
+
+output "public_actions" {
+  value = keys(data.strapi_role.public.permissions)
+}
+
+output "editor_count" {
+  value = data.strapi_role.editor.user_count
+}
@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    strapi = {
+      source  = "fbritoferreira/strapi"
+      version = "0.1.0"
+    }
+  }
+}
+
+provider "strapi" {
+  endpoint  = "http://localhost:1337"
+  api_token = "your-api-token-here"
+}
+
+# Look up a user managed by another team
+data "strapi_user" "editor" {
+  email = "editor@example.com"
+}
+
+# Grant the same role to a new account
+resource "strapi_user" "assistant" {
+  username = "assistant"
+  email    = "assistant@example.com"
+  role_id  = data.strapi_user.editor.role_id
+}
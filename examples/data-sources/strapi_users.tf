@@ -0,0 +1,55 @@
+terraform {
+  required_providers {
+    strapi = {
+      source  = "fbritoferreira/strapi"
+      version = "0.1.0"
+    }
+  }
+}
+
+provider "strapi" {
+  endpoint  = "http://localhost:1337"
+  api_token = "your-api-token-here"
+}
+
+# Audit blocked or unconfirmed accounts with the Authenticated role
+data "strapi_users" "blocked" {
+  filter {
+    field  = "blocked"
+    values = ["true"]
+  }
+
+  filter {
+    field  = "role.name"
+    values = ["Authenticated"]
+  }
+
+  sort = ["email:asc"]
+}
+
+data "strapi_users" "unconfirmed" {
+  filter {
+    field  = "confirmed"
+    values = ["false"]
+  }
+
+  sort  = ["createdAt:desc"]
+  limit = 50
+}
+
+# Look up several accounts at once
+data "strapi_users" "staff" {
+  filter {
+    field    = "email"
+    operator = "$in"
+    values   = ["alice@example.com", "bob@example.com"]
+  }
+}
+
+output "blocked_emails" {
+  value = data.strapi_users.blocked.users[*].email
+}
+
+output "unconfirmed_count" {
+  value = length(data.strapi_users.unconfirmed.users)
+}
@@ -0,0 +1,34 @@
+terraform {
+  required_providers {
+    strapi = {
+      source  = "fbritoferreira/strapi"
+      version = "0.1.0"
+    }
+  }
+}
+
+provider "strapi" {
+  endpoint  = "http://localhost:1337"
+  api_token = "your-api-token-here"
+}
+
+# Create admin roles for the editorial workflow
+resource "strapi_admin_role" "reviewer" {
+  name        = "Reviewer"
+  description = "Can review and publish content"
+}
+
+resource "strapi_admin_role" "translator" {
+  name        = "Translator"
+  description = "Can translate existing content"
+}
+
+# Assign the reviewer role to an admin user
+resource "strapi_admin_user" "reviewer" {
+  email     = "reviewer@example.com"
+  firstname = "Rita"
+  lastname  = "Reviewer"
+  password  = "ReviewerPass123"
+
+  roles = [tonumber(strapi_admin_role.reviewer.id)]
+}
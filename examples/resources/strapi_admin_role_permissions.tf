@@ -0,0 +1,45 @@
+terraform {
+  required_providers {
+    strapi = {
+      source  = "fbritoferreira/strapi"
+      version = "0.1.0"
+    }
+  }
+}
+
+provider "strapi" {
+  endpoint  = "http://localhost:1337"
+  api_token = "your-api-token-here"
+}
+
+resource "strapi_admin_role" "author" {
+  name        = "Blog Author"
+  description = "Can write and edit their own articles"
+}
+
+# Authors can create articles and only update the ones they created
+resource "strapi_admin_role_permissions" "author" {
+  role_id = strapi_admin_role.author.id
+
+  permissions = [
+    {
+      action  = "plugin::content-manager.explorer.create"
+      subject = "api::article.article"
+      fields  = ["title", "body", "cover"]
+    },
+    {
+      action  = "plugin::content-manager.explorer.read"
+      subject = "api::article.article"
+      fields  = ["title", "body", "cover"]
+    },
+    {
+      action     = "plugin::content-manager.explorer.update"
+      subject    = "api::article.article"
+      fields     = ["title", "body", "cover"]
+      conditions = ["admin::is-creator"]
+    },
+    {
+      action = "plugin::upload.read"
+    },
+  ]
+}
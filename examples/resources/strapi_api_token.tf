@@ -0,0 +1,39 @@
+terraform {
+  required_providers {
+    strapi = {
+      source  = "fbritoferreira/strapi"
+      version = "0.1.0"
+    }
+  }
+}
+
+provider "strapi" {
+  endpoint  = "http://localhost:1337"
+  api_token = "your-api-token-here"
+}
+
+# Read-only token for the public website
+resource "strapi_api_token" "frontend" {
+  name        = "Frontend"
+  description = "Read-only access for the public website"
+}
+
+# Custom token for CI, rotated every quarter
+resource "strapi_api_token" "ci" {
+  name               = "CI"
+  description        = "Publishes articles from the CI pipeline"
+  type               = "custom"
+  lifespan           = 90
+  permissions        = ["api::article.article.find", "api::article.article.create", "api::article.article.update"]
+  regenerate_trigger = "2025-Q1"
+}
+
+output "frontend_token" {
+  value     = strapi_api_token.frontend.access_key
+  sensitive = true
+}
+
+output "ci_token" {
+  value     = strapi_api_token.ci.access_key
+  sensitive = true
+}
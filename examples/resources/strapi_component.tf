@@ -0,0 +1,71 @@
+terraform {
+  required_providers {
+    strapi = {
+      source  = "fbritoferreira/strapi"
+      version = "0.1.0"
+    }
+  }
+}
+
+provider "strapi" {
+  endpoint  = "http://localhost:1337"
+  api_token = "your-api-token-here"
+}
+
+# Create a shared media component
+resource "strapi_component" "media" {
+  category     = "shared"
+  display_name = "media"
+  icon         = "picture"
+
+  attributes = {
+    file = {
+      type          = "media"
+      allowed_types = ["images", "videos"]
+    }
+    caption = {
+      type = "string"
+    }
+  }
+}
+
+# Create a shared SEO component that nests the media component
+resource "strapi_component" "seo" {
+  category     = "shared"
+  display_name = "seo"
+  icon         = "search"
+
+  attributes = {
+    meta_title = {
+      type       = "string"
+      required   = true
+      max_length = 60
+    }
+    meta_description = {
+      type = "text"
+    }
+    share_image = {
+      type      = "component"
+      component = strapi_component.media.uid
+    }
+  }
+}
+
+# Use the SEO component in a content type
+resource "strapi_content_type" "page" {
+  kind          = "collectionType"
+  singular_name = "page"
+  plural_name   = "pages"
+  display_name  = "Page"
+
+  attributes = {
+    title = {
+      type     = "string"
+      required = true
+    }
+    seo = {
+      type      = "component"
+      component = strapi_component.seo.uid
+    }
+  }
+}
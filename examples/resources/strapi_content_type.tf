@@ -0,0 +1,72 @@
+terraform {
+  required_providers {
+    strapi = {
+      source  = "fbritoferreira/strapi"
+      version = "0.1.0"
+    }
+  }
+}
+
+provider "strapi" {
+  endpoint  = "http://localhost:1337"
+  api_token = "your-api-token-here"
+}
+
+# Create a collection type for categories
+resource "strapi_content_type" "category" {
+  kind          = "collectionType"
+  singular_name = "category"
+  plural_name   = "categories"
+  display_name  = "Category"
+
+  attributes = {
+    name = {
+      type     = "string"
+      required = true
+      unique   = true
+    }
+  }
+}
+
+# Create a collection type for articles related to categories
+resource "strapi_content_type" "article" {
+  kind              = "collectionType"
+  singular_name     = "article"
+  plural_name       = "articles"
+  display_name      = "Article"
+  draft_and_publish = true
+
+  attributes = {
+    title = {
+      type     = "string"
+      required = true
+    }
+    body = {
+      type = "richtext"
+    }
+    status = {
+      type    = "enumeration"
+      enum    = ["draft", "review", "final"]
+      default = "draft"
+    }
+    category = {
+      type     = "relation"
+      relation = "manyToOne"
+      target   = strapi_content_type.category.uid
+    }
+  }
+}
+
+# Create a single type for the homepage
+resource "strapi_content_type" "homepage" {
+  kind          = "singleType"
+  singular_name = "homepage"
+  plural_name   = "homepages"
+  display_name  = "Homepage"
+
+  attributes = {
+    headline = {
+      type = "string"
+    }
+  }
+}
@@ -0,0 +1,36 @@
+terraform {
+  required_providers {
+    strapi = {
+      source  = "fbritoferreira/strapi"
+      version = "0.1.0"
+    }
+  }
+}
+
+provider "strapi" {
+  endpoint  = "http://localhost:1337"
+  api_token = "your-api-token-here"
+}
+
+# Seed a navigation menu
+resource "strapi_entry" "main_menu" {
+  content_type = "menus"
+
+  data = jsonencode({
+    name = "main"
+    items = [
+      { label = "Home", url = "/" },
+      { label = "Blog", url = "/blog" },
+    ]
+  })
+}
+
+# Seed a feature flag
+resource "strapi_entry" "new_checkout" {
+  content_type = "feature-flags"
+
+  data = jsonencode({
+    key     = "new-checkout"
+    enabled = false
+  })
+}
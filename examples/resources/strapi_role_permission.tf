@@ -0,0 +1,38 @@
+terraform {
+  required_providers {
+    strapi = {
+      source  = "fbritoferreira/strapi"
+      version = "0.1.0"
+    }
+  }
+}
+
+provider "strapi" {
+  endpoint  = "http://localhost:1337"
+  api_token = "your-api-token-here"
+}
+
+# Look up the built-in roles
+data "strapi_roles" "all" {}
+
+locals {
+  public_role_id        = [for role in data.strapi_roles.all.roles : role.id if role.type == "public"][0]
+  authenticated_role_id = [for role in data.strapi_roles.all.roles : role.id if role.type == "authenticated"][0]
+}
+
+# Allow anyone to read articles
+resource "strapi_role_permission" "public_find_articles" {
+  role_id = local.public_role_id
+  action  = "api::article.article.find"
+}
+
+resource "strapi_role_permission" "public_find_one_article" {
+  role_id = local.public_role_id
+  action  = "api::article.article.findOne"
+}
+
+# Allow logged-in users to create articles
+resource "strapi_role_permission" "authenticated_create_article" {
+  role_id = local.authenticated_role_id
+  action  = "api::article.article.create"
+}
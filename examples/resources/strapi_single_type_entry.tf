@@ -0,0 +1,33 @@
+terraform {
+  required_providers {
+    strapi = {
+      source  = "fbritoferreira/strapi"
+      version = "0.1.0"
+    }
+  }
+}
+
+provider "strapi" {
+  endpoint  = "http://localhost:1337"
+  api_token = "your-api-token-here"
+}
+
+# Publish the homepage content
+resource "strapi_single_type_entry" "homepage" {
+  content_type = "homepage"
+
+  data = jsonencode({
+    headline = "Welcome"
+    intro    = "Content managed with Terraform"
+  })
+}
+
+# Keep global settings in Strapi even if the resource is destroyed
+resource "strapi_single_type_entry" "global" {
+  content_type    = "global"
+  deletion_policy = "retain"
+
+  data = jsonencode({
+    site_name = "My Site"
+  })
+}
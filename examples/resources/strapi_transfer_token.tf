@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    strapi = {
+      source  = "fbritoferreira/strapi"
+      version = "0.1.0"
+    }
+  }
+}
+
+provider "strapi" {
+  endpoint  = "http://localhost:1337"
+  api_token = "your-api-token-here"
+}
+
+# Token on staging that production uses to pull content
+resource "strapi_transfer_token" "staging" {
+  name        = "Production pull"
+  description = "Lets production pull content from staging"
+  lifespan    = 30
+  permissions = ["pull"]
+}
+
+output "staging_transfer_token" {
+  value     = strapi_transfer_token.staging.access_key
+  sensitive = true
+}
@@ -27,3 +27,18 @@ resource "strapi_user" "john_doe" {
   blocked   = false
   role_name = strapi_role.editor.name
 }
+
+# Create a service account whose password is rotated by bumping password_version
+variable "service_account_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "strapi_user" "integration_tests" {
+  username         = "integration_tests"
+  email            = "integration-tests@example.com"
+  password         = var.service_account_password
+  password_version = 1
+  confirmed        = true
+}
@@ -0,0 +1,37 @@
+terraform {
+  required_providers {
+    strapi = {
+      source  = "fbritoferreira/strapi"
+      version = "0.1.0"
+    }
+  }
+}
+
+provider "strapi" {
+  endpoint  = "http://localhost:1337"
+  api_token = "your-api-token-here"
+}
+
+variable "cdn_token" {
+  type      = string
+  sensitive = true
+}
+
+# Purge the CDN cache whenever content is published
+resource "strapi_webhook" "cache" {
+  name   = "CDN cache invalidation"
+  url    = "https://cdn.example.com/purge"
+  events = ["entry.publish", "entry.unpublish", "media.update", "media.delete"]
+
+  headers = {
+    Authorization = "Bearer ${var.cdn_token}"
+  }
+}
+
+# Webhook kept in place but disabled
+resource "strapi_webhook" "search" {
+  name    = "Search indexing"
+  url     = "https://search.example.com/hooks/strapi"
+  events  = ["entry.create", "entry.update", "entry.delete"]
+  enabled = false
+}
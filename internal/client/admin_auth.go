@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// adminPathPrefixes lists the endpoints served by the admin panel, which only accept an admin JWT on a stock
+// Strapi instance. The content API under /api keeps using the API token.
+var adminPathPrefixes = []string{
+	"/admin/",
+	"/content-type-builder/",
+	"/content-manager/",
+}
+
+// usesAdminLogin reports whether a request to path authenticates with the admin JWT obtained from the admin
+// credentials of the client
+func (c *StrapiClient) usesAdminLogin(path string) bool {
+	if c.AdminEmail == "" || c.AdminPassword == "" {
+		return false
+	}
+
+	for _, prefix := range adminPathPrefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// adminToken returns the cached admin JWT, logging in first when there is none
+func (c *StrapiClient) adminToken(ctx context.Context) (string, error) {
+	c.adminJWTMu.Lock()
+	defer c.adminJWTMu.Unlock()
+
+	if c.adminJWT == "" {
+		token, err := c.adminLogin(ctx)
+		if err != nil {
+			return "", err
+		}
+		c.adminJWT = token
+	}
+
+	return c.adminJWT, nil
+}
+
+// refreshAdminToken replaces a rejected admin JWT with a new one. When another request already refreshed the
+// token in the meantime, the new token is returned without logging in again.
+func (c *StrapiClient) refreshAdminToken(ctx context.Context, rejected string) (string, error) {
+	c.adminJWTMu.Lock()
+	defer c.adminJWTMu.Unlock()
+
+	if c.adminJWT != "" && c.adminJWT != rejected {
+		return c.adminJWT, nil
+	}
+
+	token, err := c.adminLogin(ctx)
+	if err != nil {
+		c.adminJWT = ""
+		return "", err
+	}
+	c.adminJWT = token
+
+	return token, nil
+}
+
+// adminLogin logs in to the admin panel with the admin credentials of the client and returns the JWT
+func (c *StrapiClient) adminLogin(ctx context.Context) (string, error) {
+	payload, err := json.Marshal(map[string]string{
+		"email":    c.AdminEmail,
+		"password": c.AdminPassword,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	resp, body, err := c.sendWithToken(ctx, "POST", "/admin/login", payload, "")
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return "", newAPIError("log in to the admin panel", resp, body)
+	}
+
+	var result struct {
+		Data struct {
+			Token string `json:"token"`
+		} `json:"data"`
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return "", err
+	}
+
+	if result.Data.Token == "" {
+		return "", errors.New("failed to log in to the admin panel: no token in response")
+	}
+
+	return result.Data.Token, nil
+}
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAdminLogin(t *testing.T) {
+	var logins int
+	validToken := ""
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+
+		switch {
+		case r.URL.Path == "/admin/login":
+			logins++
+			validToken = fmt.Sprintf("jwt-%d", logins)
+			fmt.Fprintf(w, `{"data":{"token":%q,"user":{"id":1}}}`, validToken)
+		case r.URL.Path == "/api/users/1":
+			if auth != "Bearer api-token" {
+				t.Errorf("expected content API request to use the API token, got %q", auth)
+			}
+			fmt.Fprint(w, `{"id":1}`)
+		case auth == "Bearer "+validToken:
+			fmt.Fprint(w, `{"data":{"id":1,"name":"Editor"}}`)
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer server.Close()
+
+	c := New(server.URL, "api-token")
+	c.AdminEmail = "admin@example.com"
+	c.AdminPassword = "secret"
+	ctx := context.Background()
+
+	if _, err := c.GetAdminRole(ctx, 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := c.GetAdminRole(ctx, 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if logins != 1 {
+		t.Fatalf("expected the admin JWT to be cached, got %d logins", logins)
+	}
+
+	// Simulate the expiry of the cached token.
+	validToken = "expired"
+	if _, err := c.GetAdminRole(ctx, 1); err != nil {
+		t.Fatalf("expected the request to succeed after logging in again, got: %v", err)
+	}
+	if logins != 2 {
+		t.Fatalf("expected a second login after the token was rejected, got %d logins", logins)
+	}
+
+	if _, err := c.GetUser(ctx, 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
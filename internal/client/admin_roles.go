@@ -0,0 +1,145 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// AdminRole represents a Strapi admin panel role
+type AdminRole struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Code        string `json:"code,omitempty"`
+	Description string `json:"description,omitempty"`
+	UsersCount  int    `json:"usersCount,omitempty"`
+	CreatedAt   string `json:"createdAt,omitempty"`
+	UpdatedAt   string `json:"updatedAt,omitempty"`
+}
+
+// GetAdminRoles retrieves all admin roles from Strapi
+func (c *StrapiClient) GetAdminRoles(ctx context.Context) ([]AdminRole, error) {
+	var result struct {
+		Data []AdminRole `json:"data"`
+	}
+
+	if err := c.doRequest(ctx, "GET", "/admin/roles", nil, &result, "get admin roles"); err != nil {
+		return nil, err
+	}
+
+	return result.Data, nil
+}
+
+// GetAdminRole retrieves an admin role by ID
+func (c *StrapiClient) GetAdminRole(ctx context.Context, id int) (*AdminRole, error) {
+	var result struct {
+		Data AdminRole `json:"data"`
+	}
+
+	if err := c.doRequest(ctx, "GET", "/admin/roles/"+strconv.Itoa(id), nil, &result, "get admin role"); err != nil {
+		return nil, err
+	}
+
+	return &result.Data, nil
+}
+
+// FindAdminRoleByName retrieves an admin role by name
+func (c *StrapiClient) FindAdminRoleByName(ctx context.Context, name string) (*AdminRole, error) {
+	roles, err := c.GetAdminRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, role := range roles {
+		if strings.EqualFold(role.Name, name) {
+			return &role, nil
+		}
+	}
+
+	return nil, fmt.Errorf("admin role not found: %s", name)
+}
+
+// CreateAdminRole creates a new admin role
+func (c *StrapiClient) CreateAdminRole(ctx context.Context, role AdminRole) (*AdminRole, error) {
+	payload := map[string]interface{}{
+		"name":        role.Name,
+		"description": role.Description,
+	}
+
+	var result struct {
+		Data AdminRole `json:"data"`
+	}
+
+	if err := c.doRequest(ctx, "POST", "/admin/roles", payload, &result, "create admin role"); err != nil {
+		return nil, err
+	}
+
+	return &result.Data, nil
+}
+
+// UpdateAdminRole updates an existing admin role
+func (c *StrapiClient) UpdateAdminRole(ctx context.Context, id int, role AdminRole) (*AdminRole, error) {
+	payload := map[string]interface{}{
+		"name":        role.Name,
+		"description": role.Description,
+	}
+
+	var result struct {
+		Data AdminRole `json:"data"`
+	}
+
+	if err := c.doRequest(ctx, "PUT", "/admin/roles/"+strconv.Itoa(id), payload, &result, "update admin role"); err != nil {
+		return nil, err
+	}
+
+	return &result.Data, nil
+}
+
+// DeleteAdminRole deletes an admin role
+func (c *StrapiClient) DeleteAdminRole(ctx context.Context, id int) error {
+	return c.doRequest(ctx, "DELETE", "/admin/roles/"+strconv.Itoa(id), nil, nil, "delete admin role")
+}
+
+// AdminPermission represents a permission of an admin role
+type AdminPermission struct {
+	ID         int                    `json:"id,omitempty"`
+	Action     string                 `json:"action"`
+	Subject    *string                `json:"subject"`
+	Properties map[string]interface{} `json:"properties"`
+	Conditions []string               `json:"conditions"`
+}
+
+// GetAdminRolePermissions retrieves the permissions of an admin role
+func (c *StrapiClient) GetAdminRolePermissions(ctx context.Context, id int) ([]AdminPermission, error) {
+	var result struct {
+		Data []AdminPermission `json:"data"`
+	}
+
+	if err := c.doRequest(ctx, "GET", "/admin/roles/"+strconv.Itoa(id)+"/permissions", nil, &result, "get admin role permissions"); err != nil {
+		return nil, err
+	}
+
+	return result.Data, nil
+}
+
+// UpdateAdminRolePermissions replaces the full permission set of an admin role
+func (c *StrapiClient) UpdateAdminRolePermissions(ctx context.Context, id int, permissions []AdminPermission) ([]AdminPermission, error) {
+	if permissions == nil {
+		permissions = []AdminPermission{}
+	}
+
+	payload := map[string]interface{}{
+		"permissions": permissions,
+	}
+
+	var result struct {
+		Data []AdminPermission `json:"data"`
+	}
+
+	if err := c.doRequest(ctx, "PUT", "/admin/roles/"+strconv.Itoa(id)+"/permissions", payload, &result, "update admin role permissions"); err != nil {
+		return nil, err
+	}
+
+	return result.Data, nil
+}
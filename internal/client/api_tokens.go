@@ -0,0 +1,111 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+)
+
+// APIToken represents a Strapi API token
+type APIToken struct {
+	ID          int         `json:"id"`
+	Name        string      `json:"name"`
+	Description string      `json:"description,omitempty"`
+	Type        string      `json:"type"`
+	AccessKey   string      `json:"accessKey,omitempty"`
+	Lifespan    json.Number `json:"lifespan,omitempty"`
+	ExpiresAt   string      `json:"expiresAt,omitempty"`
+	LastUsedAt  string      `json:"lastUsedAt,omitempty"`
+	Permissions []string    `json:"permissions"`
+	CreatedAt   string      `json:"createdAt,omitempty"`
+	UpdatedAt   string      `json:"updatedAt,omitempty"`
+}
+
+// GetAPIToken retrieves an API token by ID. The access key is only returned when Strapi is configured
+// to store it encrypted.
+func (c *StrapiClient) GetAPIToken(ctx context.Context, id int) (*APIToken, error) {
+	var result struct {
+		Data APIToken `json:"data"`
+	}
+
+	if err := c.doRequest(ctx, "GET", "/admin/api-tokens/"+strconv.Itoa(id), nil, &result, "get API token"); err != nil {
+		return nil, err
+	}
+
+	return &result.Data, nil
+}
+
+// CreateAPIToken creates a new API token. The returned token holds the access key.
+func (c *StrapiClient) CreateAPIToken(ctx context.Context, token APIToken) (*APIToken, error) {
+	payload := map[string]interface{}{
+		"name":        token.Name,
+		"description": token.Description,
+		"type":        token.Type,
+		"lifespan":    tokenLifespan(token.Lifespan),
+		"permissions": tokenPermissions(token.Permissions),
+	}
+
+	var result struct {
+		Data APIToken `json:"data"`
+	}
+
+	if err := c.doRequest(ctx, "POST", "/admin/api-tokens", payload, &result, "create API token"); err != nil {
+		return nil, err
+	}
+
+	return &result.Data, nil
+}
+
+// UpdateAPIToken updates an existing API token. The lifespan of a token cannot be changed.
+func (c *StrapiClient) UpdateAPIToken(ctx context.Context, id int, token APIToken) (*APIToken, error) {
+	payload := map[string]interface{}{
+		"name":        token.Name,
+		"description": token.Description,
+		"type":        token.Type,
+		"permissions": tokenPermissions(token.Permissions),
+	}
+
+	var result struct {
+		Data APIToken `json:"data"`
+	}
+
+	if err := c.doRequest(ctx, "PUT", "/admin/api-tokens/"+strconv.Itoa(id), payload, &result, "update API token"); err != nil {
+		return nil, err
+	}
+
+	return &result.Data, nil
+}
+
+// RegenerateAPIToken replaces the access key of an API token. The returned token holds the new access key.
+func (c *StrapiClient) RegenerateAPIToken(ctx context.Context, id int) (*APIToken, error) {
+	var result struct {
+		Data APIToken `json:"data"`
+	}
+
+	if err := c.doRequest(ctx, "POST", "/admin/api-tokens/"+strconv.Itoa(id)+"/regenerate", nil, &result, "regenerate API token"); err != nil {
+		return nil, err
+	}
+
+	return &result.Data, nil
+}
+
+// DeleteAPIToken deletes an API token
+func (c *StrapiClient) DeleteAPIToken(ctx context.Context, id int) error {
+	return c.doRequest(ctx, "DELETE", "/admin/api-tokens/"+strconv.Itoa(id), nil, nil, "delete API token")
+}
+
+// tokenLifespan returns the lifespan in milliseconds to send to Strapi, where null means the token never expires
+func tokenLifespan(lifespan json.Number) interface{} {
+	if lifespan == "" {
+		return nil
+	}
+	return lifespan
+}
+
+// tokenPermissions returns the permissions to send to Strapi, which expects an array even when empty
+func tokenPermissions(permissions []string) []string {
+	if permissions == nil {
+		return []string{}
+	}
+	return permissions
+}
@@ -2,227 +2,339 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// DefaultRequestTimeout is the deadline applied to a single request to Strapi unless configured otherwise
+	DefaultRequestTimeout = 60 * time.Second
+	// DefaultMaxRetries is the number of times a failed request is retried unless configured otherwise
+	DefaultMaxRetries = 3
+	// DefaultRetryMaxWait is the longest wait between two attempts unless configured otherwise
+	DefaultRetryMaxWait = 30 * time.Second
 )
 
 type StrapiClient struct {
 	Endpoint   string
 	APIToken   string
 	HTTPClient *http.Client
+
+	// AdminEmail and AdminPassword are the credentials of an admin user. When both are set, admin panel
+	// endpoints are called with an admin JWT obtained by logging in, instead of the API token.
+	AdminEmail    string
+	AdminPassword string
+
+	// RequestTimeout bounds the duration of a single request. Zero disables the per-request deadline,
+	// leaving only the cancellation of the caller's context.
+	RequestTimeout time.Duration
+	// MaxRetries is the number of times a request failing with a transient error is retried
+	MaxRetries int
+	// RetryMaxWait caps the wait between two attempts, including waits requested through Retry-After
+	RetryMaxWait time.Duration
+
+	limiter  *rate.Limiter
+	inFlight chan struct{}
+
+	adminJWTMu sync.Mutex
+	adminJWT   string
+
+	roleLocksMu sync.Mutex
+	roleLocks   map[int]*sync.Mutex
 }
 
 func New(endpoint, apiToken string) *StrapiClient {
 	return &StrapiClient{
-		Endpoint:   endpoint,
-		APIToken:   apiToken,
-		HTTPClient: &http.Client{},
+		Endpoint:       endpoint,
+		APIToken:       apiToken,
+		HTTPClient:     &http.Client{},
+		RequestTimeout: DefaultRequestTimeout,
+		MaxRetries:     DefaultMaxRetries,
+		RetryMaxWait:   DefaultRetryMaxWait,
+		roleLocks:      make(map[int]*sync.Mutex),
 	}
 }
 
-// GetContentTypes retrieves all content types from Strapi
-func (c *StrapiClient) GetContentTypes() (interface{}, error) {
-	// TODO: Implement API call
-	return nil, fmt.Errorf("not implemented")
-}
+// doRequest sends a JSON request to the Strapi API and decodes the response body into out, if provided.
+// Non-2xx responses are returned as an *APIError whose action describes the operation.
+// Transient failures are retried as described by shouldRetry, and the request is cancelled when ctx is done.
+func (c *StrapiClient) doRequest(ctx context.Context, method, path string, payload, out interface{}, action string) error {
+	var jsonData []byte
+	if payload != nil {
+		var err error
+		if jsonData, err = json.Marshal(payload); err != nil {
+			return err
+		}
+	}
 
-// CreateContentType creates a new content type
-func (c *StrapiClient) CreateContentType(data map[string]interface{}) (interface{}, error) {
-	// TODO: Implement API call
-	return nil, fmt.Errorf("not implemented")
-}
+	for attempt := 0; ; attempt++ {
+		resp, body, err := c.send(ctx, method, path, jsonData)
 
-// UpdateContentType updates an existing content type
-func (c *StrapiClient) UpdateContentType(uid string, data map[string]interface{}) (interface{}, error) {
-	// TODO: Implement API call
-	return nil, fmt.Errorf("not implemented")
-}
+		if attempt < c.MaxRetries && ctx.Err() == nil && shouldRetry(method, resp, err) {
+			wait := c.retryWait(attempt, resp)
+			tflog.Warn(ctx, fmt.Sprintf("Retrying request to %s %s in %s after a transient failure", method, path, wait), map[string]interface{}{
+				"attempt": attempt + 1,
+				"error":   retryReason(resp, err),
+			})
 
-// DeleteContentType deletes a content type
-func (c *StrapiClient) DeleteContentType(uid string) error {
-	// TODO: Implement API call
-	return fmt.Errorf("not implemented")
-}
+			if err := sleep(ctx, wait); err != nil {
+				return fmt.Errorf("failed to %s: %w", action, err)
+			}
+			continue
+		}
 
-// AdminUser represents a Strapi admin user
-type AdminUser struct {
-	ID                int    `json:"id,omitempty"`
-	Email             string `json:"email"`
-	Firstname         string `json:"firstname"`
-	Lastname          string `json:"lastname,omitempty"`
-	Password          string `json:"password,omitempty"`
-	IsActive          *bool  `json:"isActive,omitempty"`
-	PreferedLanguage  string `json:"preferedLanguage,omitempty"`
-	Roles             []int  `json:"roles,omitempty"`
-	RegistrationToken string `json:"registrationToken,omitempty"`
+		if err != nil {
+			return fmt.Errorf("failed to %s: %w", action, err)
+		}
+
+		if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+			return newAPIError(action, resp, body)
+		}
+
+		if out == nil || resp.StatusCode == http.StatusNoContent || len(bytes.TrimSpace(body)) == 0 {
+			return nil
+		}
+
+		return json.Unmarshal(body, out)
+	}
 }
 
-// GetAdminUsers retrieves all admin users from Strapi
-func (c *StrapiClient) GetAdminUsers() ([]AdminUser, error) {
-	req, err := http.NewRequest("GET", c.Endpoint+"/admin/users", nil)
+// send performs a single attempt of a request and returns the response along with its fully read body.
+// Admin panel endpoints are authenticated with the admin JWT when admin credentials are configured, logging in
+// again and replaying the request once if Strapi rejects the cached token. Other endpoints use the API token.
+func (c *StrapiClient) send(ctx context.Context, method, path string, jsonData []byte) (*http.Response, []byte, error) {
+	if !c.usesAdminLogin(path) {
+		return c.sendWithToken(ctx, method, path, jsonData, c.APIToken)
+	}
+
+	token, err := c.adminToken(ctx)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	req.Header.Set("Authorization", "Bearer "+c.APIToken)
-	req.Header.Set("Content-Type", "application/json")
+	resp, body, err := c.sendWithToken(ctx, method, path, jsonData, token)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, body, err
+	}
 
-	resp, err := c.HTTPClient.Do(req)
+	token, err = c.refreshAdminToken(ctx, token)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to get admin users: %s - %s", resp.Status, string(body))
-	}
+	return c.sendWithToken(ctx, method, path, jsonData, token)
+}
 
-	var result struct {
-		Data struct {
-			Results []AdminUser `json:"results"`
-		} `json:"data"`
+// sendWithToken performs a single attempt of a request authenticated with the given bearer token, if any.
+// The attempt waits for the rate limit and concurrency cap of the client, and is bounded by its request timeout.
+func (c *StrapiClient) sendWithToken(ctx context.Context, method, path string, jsonData []byte, token string) (*http.Response, []byte, error) {
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return nil, nil, err
 	}
+	defer release()
 
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, err
+	if c.RequestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.RequestTimeout)
+		defer cancel()
 	}
 
-	return result.Data.Results, nil
-}
+	var body io.Reader
+	if jsonData != nil {
+		body = bytes.NewReader(jsonData)
+	}
 
-// GetAdminUser retrieves an admin user by ID
-func (c *StrapiClient) GetAdminUser(id int) (*AdminUser, error) {
-	req, err := http.NewRequest("GET", c.Endpoint+"/admin/users/"+strconv.Itoa(id), nil)
+	req, err := http.NewRequestWithContext(ctx, method, c.Endpoint+path, body)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	req.Header.Set("Authorization", "Bearer "+c.APIToken)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
 	req.Header.Set("Content-Type", "application/json")
 
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to get admin user: %s - %s", resp.Status, string(body))
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	var result struct {
-		Data AdminUser `json:"data"`
-	}
+	return resp, respBody, nil
+}
 
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, err
-	}
+// AdminUser represents a Strapi admin user
+type AdminUser struct {
+	ID                int    `json:"id,omitempty"`
+	Email             string `json:"email"`
+	Firstname         string `json:"firstname"`
+	Lastname          string `json:"lastname,omitempty"`
+	Password          string `json:"password,omitempty"`
+	IsActive          *bool  `json:"isActive,omitempty"`
+	PreferedLanguage  string `json:"preferedLanguage,omitempty"`
+	Roles             []int  `json:"roles,omitempty"`
+	RegistrationToken string `json:"registrationToken,omitempty"`
+	Username          string `json:"username,omitempty"`
+	Blocked           bool   `json:"blocked,omitempty"`
 
-	return &result.Data, nil
+	// AssignedRoles holds the roles of the user when Strapi returns them as objects rather than IDs
+	AssignedRoles []AdminRole `json:"-"`
 }
 
-// CreateAdminUser creates a new admin user
-func (c *StrapiClient) CreateAdminUser(user AdminUser) (*AdminUser, error) {
-	jsonData, err := json.Marshal(user)
-	if err != nil {
-		return nil, err
+// UnmarshalJSON decodes an admin user whose roles are given either as IDs or as role objects,
+// as returned by the admin API.
+func (u *AdminUser) UnmarshalJSON(data []byte) error {
+	type adminUser AdminUser
+	var raw struct {
+		adminUser
+		Roles []json.RawMessage `json:"roles"`
 	}
 
-	req, err := http.NewRequest("POST", c.Endpoint+"/admin/users", bytes.NewBuffer(jsonData))
-	if err != nil {
-		return nil, err
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
 	}
 
-	req.Header.Set("Authorization", "Bearer "+c.APIToken)
-	req.Header.Set("Content-Type", "application/json")
+	*u = AdminUser(raw.adminUser)
+	u.Roles = nil
+	u.AssignedRoles = nil
 
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, err
+	for _, rawRole := range raw.Roles {
+		var id int
+		if err := json.Unmarshal(rawRole, &id); err == nil {
+			u.Roles = append(u.Roles, id)
+			continue
+		}
+
+		var role AdminRole
+		if err := json.Unmarshal(rawRole, &role); err != nil {
+			return fmt.Errorf("invalid admin user role: %w", err)
+		}
+		u.Roles = append(u.Roles, role.ID)
+		u.AssignedRoles = append(u.AssignedRoles, role)
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to create admin user: %s - %s", resp.Status, string(body))
+	return nil
+}
+
+// GetAdminUsers retrieves all admin users from Strapi
+func (c *StrapiClient) GetAdminUsers(ctx context.Context) ([]AdminUser, error) {
+	return collect(c.AdminUsers(ctx, nil))
+}
+
+// AdminUsers returns an iterator over the admin users matching the query, such as filters or sort parameters.
+// Pages are requested with page/pageSize as the iteration progresses, and iteration stops after the first error.
+func (c *StrapiClient) AdminUsers(ctx context.Context, query url.Values) iter.Seq2[AdminUser, error] {
+	return func(yield func(AdminUser, error) bool) {
+		params := cloneQuery(query)
+		params.Set("pageSize", strconv.Itoa(pageSize))
+
+		for page := 1; ; page++ {
+			params.Set("page", strconv.Itoa(page))
+
+			var result struct {
+				Data struct {
+					Results    []AdminUser `json:"results"`
+					Pagination struct {
+						PageCount int `json:"pageCount"`
+					} `json:"pagination"`
+				} `json:"data"`
+			}
+
+			if err := c.doRequest(ctx, "GET", "/admin/users?"+params.Encode(), nil, &result, "get admin users"); err != nil {
+				yield(AdminUser{}, err)
+				return
+			}
+
+			for _, user := range result.Data.Results {
+				if !yield(user, nil) {
+					return
+				}
+			}
+
+			if page >= result.Data.Pagination.PageCount || len(result.Data.Results) == 0 {
+				return
+			}
+		}
 	}
+}
 
+// GetAdminUser retrieves an admin user by ID
+func (c *StrapiClient) GetAdminUser(ctx context.Context, id int) (*AdminUser, error) {
 	var result struct {
 		Data AdminUser `json:"data"`
 	}
 
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	if err := c.doRequest(ctx, "GET", "/admin/users/"+strconv.Itoa(id), nil, &result, "get admin user"); err != nil {
 		return nil, err
 	}
 
 	return &result.Data, nil
 }
 
-// UpdateAdminUser updates an existing admin user
-func (c *StrapiClient) UpdateAdminUser(id int, user AdminUser) (*AdminUser, error) {
-	jsonData, err := json.Marshal(user)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("PUT", c.Endpoint+"/admin/users/"+strconv.Itoa(id), bytes.NewBuffer(jsonData))
-	if err != nil {
-		return nil, err
-	}
+// FindAdminUserByEmail retrieves an admin user by email, with its roles
+func (c *StrapiClient) FindAdminUserByEmail(ctx context.Context, email string) (*AdminUser, error) {
+	query := url.Values{}
+	query.Set("filters[email][$eqi]", email)
 
-	req.Header.Set("Authorization", "Bearer "+c.APIToken)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, err
+	for user, err := range c.AdminUsers(ctx, query) {
+		if err != nil {
+			return nil, err
+		}
+		if strings.EqualFold(user.Email, email) {
+			return &user, nil
+		}
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to update admin user: %s - %s", resp.Status, string(body))
-	}
+	return nil, fmt.Errorf("admin user not found: %s", email)
+}
 
+// CreateAdminUser creates a new admin user
+func (c *StrapiClient) CreateAdminUser(ctx context.Context, user AdminUser) (*AdminUser, error) {
 	var result struct {
 		Data AdminUser `json:"data"`
 	}
 
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	if err := c.doRequest(ctx, "POST", "/admin/users", user, &result, "create admin user"); err != nil {
 		return nil, err
 	}
 
 	return &result.Data, nil
 }
 
-// DeleteAdminUser deletes an admin user
-func (c *StrapiClient) DeleteAdminUser(id int) error {
-	req, err := http.NewRequest("DELETE", c.Endpoint+"/admin/users/"+strconv.Itoa(id), nil)
-	if err != nil {
-		return err
+// UpdateAdminUser updates an existing admin user
+func (c *StrapiClient) UpdateAdminUser(ctx context.Context, id int, user AdminUser) (*AdminUser, error) {
+	var result struct {
+		Data AdminUser `json:"data"`
 	}
 
-	req.Header.Set("Authorization", "Bearer "+c.APIToken)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return err
+	if err := c.doRequest(ctx, "PUT", "/admin/users/"+strconv.Itoa(id), user, &result, "update admin user"); err != nil {
+		return nil, err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to delete admin user: %s - %s", resp.Status, string(body))
-	}
+	return &result.Data, nil
+}
 
-	return nil
+// DeleteAdminUser deletes an admin user
+func (c *StrapiClient) DeleteAdminUser(ctx context.Context, id int) error {
+	return c.doRequest(ctx, "DELETE", "/admin/users/"+strconv.Itoa(id), nil, nil, "delete admin user")
 }
 
 // User represents a Strapi content API user
@@ -231,6 +343,7 @@ type User struct {
 	DocumentID string                 `json:"documentId"`
 	Username   string                 `json:"username"`
 	Email      string                 `json:"email"`
+	Password   string                 `json:"password,omitempty"`
 	Confirmed  bool                   `json:"confirmed"`
 	Blocked    bool                   `json:"blocked"`
 	Role       map[string]interface{} `json:"role,omitempty"`
@@ -239,136 +352,144 @@ type User struct {
 }
 
 // GetUsers retrieves all users from Strapi
-func (c *StrapiClient) GetUsers() ([]User, error) {
-	req, err := http.NewRequest("GET", c.Endpoint+"/api/users", nil)
-	if err != nil {
-		return nil, err
+func (c *StrapiClient) GetUsers(ctx context.Context) ([]User, error) {
+	return collect(c.Users(ctx, nil))
+}
+
+// Users returns an iterator over the users matching the query, such as filters, sort or populate parameters.
+// Pages are requested with start/limit as the iteration progresses, and iteration stops after the first error.
+func (c *StrapiClient) Users(ctx context.Context, query url.Values) iter.Seq2[User, error] {
+	return func(yield func(User, error) bool) {
+		params := cloneQuery(query)
+		params.Set("limit", strconv.Itoa(pageSize))
+
+		// Strapi caps limit at api.rest.maxLimit, so a page may hold fewer users than requested without being the last.
+		for start := 0; ; {
+			params.Set("start", strconv.Itoa(start))
+
+			var result json.RawMessage
+			if err := c.doRequest(ctx, "GET", "/api/users?"+params.Encode(), nil, &result, "get users"); err != nil {
+				yield(User{}, err)
+				return
+			}
+
+			users, err := decodeUsers(result)
+			if err != nil {
+				yield(User{}, err)
+				return
+			}
+
+			if len(users) == 0 {
+				return
+			}
+
+			for _, user := range users {
+				if !yield(user, nil) {
+					return
+				}
+			}
+			start += len(users)
+		}
 	}
+}
 
-	req.Header.Set("Authorization", "Bearer "+c.APIToken)
-	req.Header.Set("Content-Type", "application/json")
+// FindUser retrieves the first user whose field (such as "email" or "documentId") equals value, with its role populated
+func (c *StrapiClient) FindUser(ctx context.Context, field, value string) (*User, error) {
+	query := url.Values{}
+	query.Set("filters["+field+"][$eq]", value)
+	query.Set("populate", "role")
 
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, err
+	for user, err := range c.Users(ctx, query) {
+		if err != nil {
+			return nil, err
+		}
+		return &user, nil
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to get users: %s - %s", resp.Status, string(body))
+	return nil, fmt.Errorf("user not found: %s = %s", field, value)
+}
+
+// decodeUsers decodes a page of users. The users-permissions plugin answers with a plain array, while
+// content API style responses wrap the users in a data envelope.
+func decodeUsers(raw json.RawMessage) ([]User, error) {
+	var users []User
+	if err := json.Unmarshal(raw, &users); err == nil {
+		return users, nil
 	}
 
 	var result struct {
 		Data []User `json:"data"`
-		Meta struct {
-			Pagination struct {
-				Total int `json:"total"`
-			} `json:"pagination"`
-		} `json:"meta"`
 	}
-
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	if err := json.Unmarshal(raw, &result); err != nil {
 		return nil, err
 	}
-
 	return result.Data, nil
 }
 
-// GetUser retrieves a user by ID
-func (c *StrapiClient) GetUser(id int) (*User, error) {
-	req, err := http.NewRequest("GET", c.Endpoint+"/api/users/"+strconv.Itoa(id), nil)
-	if err != nil {
-		return nil, err
+// decodeUser decodes a single user, which the users-permissions plugin returns either as a plain object
+// or wrapped in a data envelope.
+func decodeUser(raw json.RawMessage) (*User, error) {
+	var result struct {
+		Data *User `json:"data"`
 	}
-
-	req.Header.Set("Authorization", "Bearer "+c.APIToken)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
+	if err := json.Unmarshal(raw, &result); err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to get user: %s - %s", resp.Status, string(body))
+	if result.Data != nil {
+		return result.Data, nil
 	}
 
-	var result struct {
-		Data User `json:"data"`
+	var user User
+	if err := json.Unmarshal(raw, &user); err != nil {
+		return nil, err
 	}
+	return &user, nil
+}
+
+// GetUser retrieves a user by ID, with its role populated
+func (c *StrapiClient) GetUser(ctx context.Context, id int) (*User, error) {
+	var raw json.RawMessage
 
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	if err := c.doRequest(ctx, "GET", "/api/users/"+strconv.Itoa(id)+"?populate=role", nil, &raw, "get user"); err != nil {
 		return nil, err
 	}
 
-	return &result.Data, nil
+	return decodeUser(raw)
 }
 
 // CreateUser creates a new user
-func (c *StrapiClient) CreateUser(user User) (*User, error) {
-	payload := map[string]interface{}{
-		"username":  user.Username,
-		"email":     user.Email,
-		"confirmed": user.Confirmed,
-		"blocked":   user.Blocked,
-	}
-
-	if user.Role != nil {
-		if roleConnect, ok := user.Role["connect"].([]interface{}); ok && len(roleConnect) > 0 {
-			if roleData, ok := roleConnect[0].(map[string]interface{}); ok {
-				if id, ok := roleData["id"].(int); ok {
-					payload["role"] = id
-				} else if idFloat, ok := roleData["id"].(float64); ok {
-					payload["role"] = int(idFloat)
-				}
-			}
-		}
-	}
-
+func (c *StrapiClient) CreateUser(ctx context.Context, user User) (*User, error) {
 	requestBody := map[string]interface{}{
-		"data": payload,
+		"data": userPayload(user),
 	}
 
-	jsonData, err := json.Marshal(requestBody)
-	if err != nil {
-		return nil, err
-	}
+	var raw json.RawMessage
 
-	req, err := http.NewRequest("POST", c.Endpoint+"/api/users", bytes.NewBuffer(jsonData))
-	if err != nil {
+	if err := c.doRequest(ctx, "POST", "/api/users", requestBody, &raw, "create user"); err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("Authorization", "Bearer "+c.APIToken)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+	return decodeUser(raw)
+}
 
-	if resp.StatusCode != http.StatusCreated {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to create user: %s - %s", resp.Status, string(body))
+// UpdateUser updates an existing user
+func (c *StrapiClient) UpdateUser(ctx context.Context, id int, user User) (*User, error) {
+	requestBody := map[string]interface{}{
+		"data": userPayload(user),
 	}
 
-	var result struct {
-		Data User `json:"data"`
-	}
+	var raw json.RawMessage
 
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	if err := c.doRequest(ctx, "PUT", "/api/users/"+strconv.Itoa(id), requestBody, &raw, "update user"); err != nil {
 		return nil, err
 	}
 
-	return &result.Data, nil
+	return decodeUser(raw)
 }
 
-// UpdateUser updates an existing user
-func (c *StrapiClient) UpdateUser(id int, user User) (*User, error) {
+// userPayload builds the request body of a user, resolving the role ID from its connect form.
+// The password is only sent when set, so that updates leave the current password unchanged.
+func userPayload(user User) map[string]interface{} {
 	payload := map[string]interface{}{
 		"username":  user.Username,
 		"email":     user.Email,
@@ -376,11 +497,15 @@ func (c *StrapiClient) UpdateUser(id int, user User) (*User, error) {
 		"blocked":   user.Blocked,
 	}
 
+	if user.Password != "" {
+		payload["password"] = user.Password
+	}
+
 	if user.Role != nil {
 		if roleConnect, ok := user.Role["connect"].([]interface{}); ok && len(roleConnect) > 0 {
 			if roleData, ok := roleConnect[0].(map[string]interface{}); ok {
-				if idRole, ok := roleData["id"].(int); ok {
-					payload["role"] = idRole
+				if id, ok := roleData["id"].(int); ok {
+					payload["role"] = id
 				} else if idFloat, ok := roleData["id"].(float64); ok {
 					payload["role"] = int(idFloat)
 				}
@@ -388,67 +513,12 @@ func (c *StrapiClient) UpdateUser(id int, user User) (*User, error) {
 		}
 	}
 
-	requestBody := map[string]interface{}{
-		"data": payload,
-	}
-
-	jsonData, err := json.Marshal(requestBody)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("PUT", c.Endpoint+"/api/users/"+strconv.Itoa(id), bytes.NewBuffer(jsonData))
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Set("Authorization", "Bearer "+c.APIToken)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to update user: %s - %s", resp.Status, string(body))
-	}
-
-	var result struct {
-		Data User `json:"data"`
-	}
-
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, err
-	}
-
-	return &result.Data, nil
+	return payload
 }
 
 // DeleteUser deletes a user
-func (c *StrapiClient) DeleteUser(id int) error {
-	req, err := http.NewRequest("DELETE", c.Endpoint+"/api/users/"+strconv.Itoa(id), nil)
-	if err != nil {
-		return err
-	}
-
-	req.Header.Set("Authorization", "Bearer "+c.APIToken)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to delete user: %s - %s", resp.Status, string(body))
-	}
-
-	return nil
+func (c *StrapiClient) DeleteUser(ctx context.Context, id int) error {
+	return c.doRequest(ctx, "DELETE", "/api/users/"+strconv.Itoa(id), nil, nil, "delete user")
 }
 
 // Role represents a Strapi role
@@ -458,36 +528,18 @@ type Role struct {
 	Description string                 `json:"description"`
 	Type        string                 `json:"type"`
 	Permissions map[string]interface{} `json:"permissions,omitempty"`
+	NbUsers     int                    `json:"nb_users,omitempty"`
 	CreatedAt   string                 `json:"createdAt"`
 	UpdatedAt   string                 `json:"updatedAt"`
 }
 
 // GetRoles retrieves all roles from Strapi
-func (c *StrapiClient) GetRoles() ([]Role, error) {
-	req, err := http.NewRequest("GET", c.Endpoint+"/api/users-permissions/roles", nil)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Set("Authorization", "Bearer "+c.APIToken)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to get roles: %s - %s", resp.Status, string(body))
-	}
-
+func (c *StrapiClient) GetRoles(ctx context.Context) ([]Role, error) {
 	var result struct {
 		Roles []Role `json:"roles"`
 	}
 
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	if err := c.doRequest(ctx, "GET", "/api/users-permissions/roles", nil, &result, "get roles"); err != nil {
 		return nil, err
 	}
 
@@ -495,31 +547,12 @@ func (c *StrapiClient) GetRoles() ([]Role, error) {
 }
 
 // GetRole retrieves a role by ID
-func (c *StrapiClient) GetRole(id int) (*Role, error) {
-	req, err := http.NewRequest("GET", c.Endpoint+"/api/users-permissions/roles/"+strconv.Itoa(id), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Set("Authorization", "Bearer "+c.APIToken)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to get role: %s - %s", resp.Status, string(body))
-	}
-
+func (c *StrapiClient) GetRole(ctx context.Context, id int) (*Role, error) {
 	var result struct {
 		Role Role `json:"role"`
 	}
 
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	if err := c.doRequest(ctx, "GET", "/api/users-permissions/roles/"+strconv.Itoa(id), nil, &result, "get role"); err != nil {
 		return nil, err
 	}
 
@@ -527,58 +560,55 @@ func (c *StrapiClient) GetRole(id int) (*Role, error) {
 }
 
 // FindRoleByName retrieves a role by name
-func (c *StrapiClient) FindRoleByName(name string) (*Role, error) {
-	roles, err := c.GetRoles()
+func (c *StrapiClient) FindRoleByName(ctx context.Context, name string) (*Role, error) {
+	return c.findRole(ctx, name, func(role Role) bool {
+		return strings.EqualFold(role.Name, name)
+	})
+}
+
+// FindRoleByType retrieves a role by type (e.g., 'authenticated' or 'public')
+func (c *StrapiClient) FindRoleByType(ctx context.Context, roleType string) (*Role, error) {
+	return c.findRole(ctx, roleType, func(role Role) bool {
+		return role.Type == roleType
+	})
+}
+
+// FindRoleByID retrieves a role by ID from the list of roles, which unlike GetRole includes the number of users
+func (c *StrapiClient) FindRoleByID(ctx context.Context, id int) (*Role, error) {
+	return c.findRole(ctx, strconv.Itoa(id), func(role Role) bool {
+		return role.ID == id
+	})
+}
+
+// findRole retrieves the first role for which match returns true
+func (c *StrapiClient) findRole(ctx context.Context, key string, match func(Role) bool) (*Role, error) {
+	roles, err := c.GetRoles(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	for _, role := range roles {
-		if strings.EqualFold(role.Name, name) {
+		if match(role) {
 			return &role, nil
 		}
 	}
 
-	return nil, fmt.Errorf("role not found: %s", name)
+	return nil, fmt.Errorf("role not found: %s", key)
 }
 
 // CreateRole creates a new role
-func (c *StrapiClient) CreateRole(role Role) (*Role, error) {
+func (c *StrapiClient) CreateRole(ctx context.Context, role Role) (*Role, error) {
 	payload := map[string]interface{}{
 		"name":        role.Name,
 		"description": role.Description,
 		"type":        role.Type,
 	}
 
-	jsonData, err := json.Marshal(payload)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", c.Endpoint+"/api/users-permissions/roles", bytes.NewBuffer(jsonData))
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Set("Authorization", "Bearer "+c.APIToken)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to create role: %s - %s", resp.Status, string(body))
-	}
-
 	var result struct {
 		Role Role `json:"role"`
 	}
 
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	if err := c.doRequest(ctx, "POST", "/api/users-permissions/roles", payload, &result, "create role"); err != nil {
 		return nil, err
 	}
 
@@ -586,7 +616,7 @@ func (c *StrapiClient) CreateRole(role Role) (*Role, error) {
 }
 
 // UpdateRole updates an existing role
-func (c *StrapiClient) UpdateRole(id int, role Role) (*Role, error) {
+func (c *StrapiClient) UpdateRole(ctx context.Context, id int, role Role) (*Role, error) {
 	payload := map[string]interface{}{
 		"name":        role.Name,
 		"description": role.Description,
@@ -596,35 +626,15 @@ func (c *StrapiClient) UpdateRole(id int, role Role) (*Role, error) {
 		payload["type"] = role.Type
 	}
 
-	jsonData, err := json.Marshal(payload)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("PUT", c.Endpoint+"/api/users-permissions/roles/"+strconv.Itoa(id), bytes.NewBuffer(jsonData))
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Set("Authorization", "Bearer "+c.APIToken)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to update role: %s - %s", resp.Status, string(body))
+	if role.Permissions != nil {
+		payload["permissions"] = role.Permissions
 	}
 
 	var result struct {
 		Role Role `json:"role"`
 	}
 
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	if err := c.doRequest(ctx, "PUT", "/api/users-permissions/roles/"+strconv.Itoa(id), payload, &result, "update role"); err != nil {
 		return nil, err
 	}
 
@@ -632,25 +642,6 @@ func (c *StrapiClient) UpdateRole(id int, role Role) (*Role, error) {
 }
 
 // DeleteRole deletes a role
-func (c *StrapiClient) DeleteRole(id int) error {
-	req, err := http.NewRequest("DELETE", c.Endpoint+"/api/users-permissions/roles/"+strconv.Itoa(id), nil)
-	if err != nil {
-		return err
-	}
-
-	req.Header.Set("Authorization", "Bearer "+c.APIToken)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to delete role: %s - %s", resp.Status, string(body))
-	}
-
-	return nil
+func (c *StrapiClient) DeleteRole(ctx context.Context, id int) error {
+	return c.doRequest(ctx, "DELETE", "/api/users-permissions/roles/"+strconv.Itoa(id), nil, nil, "delete role")
 }
@@ -0,0 +1,88 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAdminUserUnmarshalRoles(t *testing.T) {
+	var user AdminUser
+	data := `{"id": 1, "email": "editor@example.com", "roles": [{"id": 2, "name": "Editor", "code": "strapi-editor"}, 3]}`
+	if err := json.Unmarshal([]byte(data), &user); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(user.Roles) != 2 || user.Roles[0] != 2 || user.Roles[1] != 3 {
+		t.Errorf("expected role IDs [2 3], got %v", user.Roles)
+	}
+	if len(user.AssignedRoles) != 1 || user.AssignedRoles[0].Code != "strapi-editor" {
+		t.Errorf("expected the editor role to be assigned, got %+v", user.AssignedRoles)
+	}
+	if user.Email != "editor@example.com" {
+		t.Errorf("expected email editor@example.com, got %q", user.Email)
+	}
+}
+
+func TestFindAdminUserByEmail(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.EqualFold(r.URL.Query().Get("filters[email][$eqi]"), "editor@example.com") {
+			_, _ = w.Write([]byte(`{"data": {"results": [], "pagination": {"pageCount": 0}}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data": {"results": [{"id": 7, "email": "editor@example.com", "roles": [{"id": 2}]}], "pagination": {"pageCount": 1}}}`))
+	}))
+	defer server.Close()
+
+	c := New(server.URL, "token")
+
+	user, err := c.FindAdminUserByEmail(context.Background(), "Editor@Example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if user.ID != 7 || len(user.Roles) != 1 || user.Roles[0] != 2 {
+		t.Fatalf("expected admin user 7 with role 2, got %+v", user)
+	}
+
+	if _, err := c.FindAdminUserByEmail(context.Background(), "missing@example.com"); err == nil {
+		t.Fatal("expected an error for an unknown email")
+	}
+}
+
+func TestGetUserPopulatesRole(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("populate"); got != "role" {
+			t.Errorf("expected the role to be populated, got populate=%q", got)
+		}
+		_, _ = w.Write([]byte(`{"id": 4, "username": "reader", "role": {"id": 1, "name": "Authenticated", "type": "authenticated"}}`))
+	}))
+	defer server.Close()
+
+	c := New(server.URL, "token")
+
+	user, err := c.GetUser(context.Background(), 4)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if user.ID != 4 || user.Username != "reader" {
+		t.Errorf("expected user 4 named reader, got %+v", user)
+	}
+	if name, _ := user.Role["name"].(string); name != "Authenticated" {
+		t.Errorf("expected the Authenticated role, got %v", user.Role)
+	}
+}
+
+func TestUserPayloadPassword(t *testing.T) {
+	payload := userPayload(User{Username: "reader", Password: "Secret123!"})
+	if payload["password"] != "Secret123!" {
+		t.Errorf("expected the password to be sent, got %v", payload)
+	}
+
+	payload = userPayload(User{Username: "reader"})
+	if _, ok := payload["password"]; ok {
+		t.Errorf("expected no password when unset, got %v", payload)
+	}
+}
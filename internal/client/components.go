@@ -0,0 +1,109 @@
+package client
+
+import (
+	"context"
+	"net/url"
+)
+
+// ComponentSchema represents the schema of a Strapi component as used by the Content-Type Builder
+type ComponentSchema struct {
+	DisplayName string                          `json:"displayName"`
+	Description string                          `json:"description,omitempty"`
+	Icon        string                          `json:"icon,omitempty"`
+	Attributes  map[string]ContentTypeAttribute `json:"attributes"`
+}
+
+// Component represents a reusable Strapi component
+type Component struct {
+	UID      string          `json:"uid"`
+	Category string          `json:"category"`
+	Schema   ComponentSchema `json:"schema"`
+}
+
+// componentPayload builds the Content-Type Builder request body for a component
+func componentPayload(category string, schema ComponentSchema) map[string]interface{} {
+	return map[string]interface{}{
+		"component": map[string]interface{}{
+			"category":    category,
+			"displayName": schema.DisplayName,
+			"description": schema.Description,
+			"icon":        schema.Icon,
+			"attributes":  schema.Attributes,
+		},
+		"components": []interface{}{},
+	}
+}
+
+// GetComponents retrieves all components from Strapi
+func (c *StrapiClient) GetComponents(ctx context.Context) ([]Component, error) {
+	var result struct {
+		Data []Component `json:"data"`
+	}
+
+	if err := c.doRequest(ctx, "GET", "/content-type-builder/components", nil, &result, "get components"); err != nil {
+		return nil, err
+	}
+
+	return result.Data, nil
+}
+
+// GetComponent retrieves a component by UID
+func (c *StrapiClient) GetComponent(ctx context.Context, uid string) (*Component, error) {
+	var result struct {
+		Data Component `json:"data"`
+	}
+
+	if err := c.doRequest(ctx, "GET", "/content-type-builder/components/"+url.PathEscape(uid), nil, &result, "get component"); err != nil {
+		return nil, err
+	}
+
+	return &result.Data, nil
+}
+
+// CreateComponent creates a new component and waits for Strapi to reload. It returns the UID of the component.
+func (c *StrapiClient) CreateComponent(ctx context.Context, category string, schema ComponentSchema) (string, error) {
+	var result struct {
+		Data struct {
+			UID string `json:"uid"`
+		} `json:"data"`
+	}
+
+	if err := c.doRequest(ctx, "POST", "/content-type-builder/components", componentPayload(category, schema), &result, "create component"); err != nil {
+		return "", err
+	}
+
+	if err := c.WaitForReload(ctx); err != nil {
+		return "", err
+	}
+
+	return result.Data.UID, nil
+}
+
+// UpdateComponent updates an existing component and waits for Strapi to reload. It returns the UID of the
+// component, which changes when the component is moved to another category or renamed.
+func (c *StrapiClient) UpdateComponent(ctx context.Context, uid, category string, schema ComponentSchema) (string, error) {
+	var result struct {
+		Data struct {
+			UID string `json:"uid"`
+		} `json:"data"`
+	}
+
+	if err := c.doRequest(ctx, "PUT", "/content-type-builder/components/"+url.PathEscape(uid), componentPayload(category, schema), &result, "update component"); err != nil {
+		return "", err
+	}
+
+	if err := c.WaitForReload(ctx); err != nil {
+		return "", err
+	}
+
+	return result.Data.UID, nil
+}
+
+// DeleteComponent deletes a component and waits for Strapi to reload
+func (c *StrapiClient) DeleteComponent(ctx context.Context, uid string) error {
+	if err := c.doRequest(ctx, "DELETE", "/content-type-builder/components/"+url.PathEscape(uid), nil, nil, "delete component"); err != nil {
+		return err
+	}
+
+	return c.WaitForReload(ctx)
+}
@@ -0,0 +1,192 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	// reloadTimeout is the maximum time to wait for Strapi to come back after a schema change
+	reloadTimeout = 2 * time.Minute
+
+	// reloadPollInterval is the delay between health checks while Strapi is restarting
+	reloadPollInterval = time.Second
+)
+
+// ContentTypeAttribute represents a single attribute of a content type or component schema
+type ContentTypeAttribute struct {
+	Type            string                 `json:"type"`
+	Required        *bool                  `json:"required,omitempty"`
+	Unique          *bool                  `json:"unique,omitempty"`
+	Private         *bool                  `json:"private,omitempty"`
+	Default         interface{}            `json:"default,omitempty"`
+	Enum            []string               `json:"enum,omitempty"`
+	MinLength       *int                   `json:"minLength,omitempty"`
+	MaxLength       *int                   `json:"maxLength,omitempty"`
+	Relation        string                 `json:"relation,omitempty"`
+	Target          string                 `json:"target,omitempty"`
+	TargetAttribute string                 `json:"targetAttribute,omitempty"`
+	Component       string                 `json:"component,omitempty"`
+	Repeatable      *bool                  `json:"repeatable,omitempty"`
+	Components      []string               `json:"components,omitempty"`
+	Multiple        *bool                  `json:"multiple,omitempty"`
+	AllowedTypes    []string               `json:"allowedTypes,omitempty"`
+	PluginOptions   map[string]interface{} `json:"pluginOptions,omitempty"`
+}
+
+// ContentTypeSchema represents the schema of a Strapi content type as used by the Content-Type Builder
+type ContentTypeSchema struct {
+	Kind            string                          `json:"kind"`
+	SingularName    string                          `json:"singularName"`
+	PluralName      string                          `json:"pluralName"`
+	DisplayName     string                          `json:"displayName"`
+	Description     string                          `json:"description,omitempty"`
+	DraftAndPublish bool                            `json:"draftAndPublish"`
+	PluginOptions   map[string]interface{}          `json:"pluginOptions,omitempty"`
+	Attributes      map[string]ContentTypeAttribute `json:"attributes"`
+}
+
+// ContentType represents a Strapi content type
+type ContentType struct {
+	UID    string            `json:"uid"`
+	APIID  string            `json:"apiID"`
+	Schema ContentTypeSchema `json:"schema"`
+}
+
+// GetContentTypes retrieves all content types from Strapi
+func (c *StrapiClient) GetContentTypes(ctx context.Context) ([]ContentType, error) {
+	var result struct {
+		Data []ContentType `json:"data"`
+	}
+
+	if err := c.doRequest(ctx, "GET", "/content-type-builder/content-types", nil, &result, "get content types"); err != nil {
+		return nil, err
+	}
+
+	return result.Data, nil
+}
+
+// GetContentType retrieves a content type by UID
+func (c *StrapiClient) GetContentType(ctx context.Context, uid string) (*ContentType, error) {
+	var result struct {
+		Data ContentType `json:"data"`
+	}
+
+	if err := c.doRequest(ctx, "GET", "/content-type-builder/content-types/"+url.PathEscape(uid), nil, &result, "get content type"); err != nil {
+		return nil, err
+	}
+
+	return &result.Data, nil
+}
+
+// CreateContentType creates a new content type and waits for Strapi to reload. It returns the UID of the content type.
+func (c *StrapiClient) CreateContentType(ctx context.Context, schema ContentTypeSchema) (string, error) {
+	payload := map[string]interface{}{
+		"contentType": schema,
+		"components":  []interface{}{},
+	}
+
+	var result struct {
+		Data struct {
+			UID string `json:"uid"`
+		} `json:"data"`
+	}
+
+	if err := c.doRequest(ctx, "POST", "/content-type-builder/content-types", payload, &result, "create content type"); err != nil {
+		return "", err
+	}
+
+	if err := c.WaitForReload(ctx); err != nil {
+		return "", err
+	}
+
+	return result.Data.UID, nil
+}
+
+// UpdateContentType updates an existing content type and waits for Strapi to reload
+func (c *StrapiClient) UpdateContentType(ctx context.Context, uid string, schema ContentTypeSchema) (string, error) {
+	payload := map[string]interface{}{
+		"contentType": schema,
+		"components":  []interface{}{},
+	}
+
+	var result struct {
+		Data struct {
+			UID string `json:"uid"`
+		} `json:"data"`
+	}
+
+	if err := c.doRequest(ctx, "PUT", "/content-type-builder/content-types/"+url.PathEscape(uid), payload, &result, "update content type"); err != nil {
+		return "", err
+	}
+
+	if err := c.WaitForReload(ctx); err != nil {
+		return "", err
+	}
+
+	return result.Data.UID, nil
+}
+
+// DeleteContentType deletes a content type and waits for Strapi to reload
+func (c *StrapiClient) DeleteContentType(ctx context.Context, uid string) error {
+	if err := c.doRequest(ctx, "DELETE", "/content-type-builder/content-types/"+url.PathEscape(uid), nil, nil, "delete content type"); err != nil {
+		return err
+	}
+
+	return c.WaitForReload(ctx)
+}
+
+// WaitForReload waits for Strapi to finish the automatic restart it performs after a schema change.
+// It polls the health endpoint until the server answers again, the reload timeout expires or ctx is done.
+func (c *StrapiClient) WaitForReload(ctx context.Context) error {
+	deadline := time.Now().Add(reloadTimeout)
+
+	for {
+		// Strapi restarts shortly after answering the Content-Type Builder request, so give it a moment
+		// to go down before polling, otherwise the old process may still report itself as healthy.
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("stopped waiting for Strapi to reload: %w", ctx.Err())
+		case <-time.After(reloadPollInterval):
+		}
+
+		if c.isHealthy(ctx) {
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out after %s waiting for Strapi to reload", reloadTimeout)
+		}
+	}
+}
+
+// isHealthy reports whether the Strapi health endpoint answers successfully
+func (c *StrapiClient) isHealthy(ctx context.Context) bool {
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return false
+	}
+	defer release()
+
+	if c.RequestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.RequestTimeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", c.Endpoint+"/_health", nil)
+	if err != nil {
+		return false
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return false
+	}
+	defer resp.Body.Close()
+
+	return resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusNoContent
+}
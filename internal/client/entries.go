@@ -0,0 +1,125 @@
+package client
+
+import (
+	"context"
+	"net/url"
+)
+
+// Entry represents a Strapi content document
+type Entry struct {
+	ID         int
+	DocumentID string
+	Data       map[string]interface{}
+}
+
+// newEntry builds an Entry from the document attributes returned by Strapi
+func newEntry(data map[string]interface{}) *Entry {
+	entry := &Entry{Data: data}
+
+	if id, ok := data["id"].(float64); ok {
+		entry.ID = int(id)
+	}
+
+	if documentID, ok := data["documentId"].(string); ok {
+		entry.DocumentID = documentID
+	}
+
+	return entry
+}
+
+// GetEntry retrieves a document of a collection type by document ID
+func (c *StrapiClient) GetEntry(ctx context.Context, pluralAPIID, documentID string) (*Entry, error) {
+	var result struct {
+		Data map[string]interface{} `json:"data"`
+	}
+
+	if err := c.doRequest(ctx, "GET", "/api/"+url.PathEscape(pluralAPIID)+"/"+url.PathEscape(documentID), nil, &result, "get entry"); err != nil {
+		return nil, err
+	}
+
+	return newEntry(result.Data), nil
+}
+
+// CreateEntry creates a new document in a collection type
+func (c *StrapiClient) CreateEntry(ctx context.Context, pluralAPIID string, data map[string]interface{}) (*Entry, error) {
+	payload := map[string]interface{}{
+		"data": data,
+	}
+
+	var result struct {
+		Data map[string]interface{} `json:"data"`
+	}
+
+	if err := c.doRequest(ctx, "POST", "/api/"+url.PathEscape(pluralAPIID), payload, &result, "create entry"); err != nil {
+		return nil, err
+	}
+
+	return newEntry(result.Data), nil
+}
+
+// UpdateEntry updates an existing document of a collection type
+func (c *StrapiClient) UpdateEntry(ctx context.Context, pluralAPIID, documentID string, data map[string]interface{}) (*Entry, error) {
+	payload := map[string]interface{}{
+		"data": data,
+	}
+
+	var result struct {
+		Data map[string]interface{} `json:"data"`
+	}
+
+	if err := c.doRequest(ctx, "PUT", "/api/"+url.PathEscape(pluralAPIID)+"/"+url.PathEscape(documentID), payload, &result, "update entry"); err != nil {
+		return nil, err
+	}
+
+	return newEntry(result.Data), nil
+}
+
+// DeleteEntry deletes a document of a collection type
+func (c *StrapiClient) DeleteEntry(ctx context.Context, pluralAPIID, documentID string) error {
+	return c.doRequest(ctx, "DELETE", "/api/"+url.PathEscape(pluralAPIID)+"/"+url.PathEscape(documentID), nil, nil, "delete entry")
+}
+
+// singleTypePath builds the content API path of a single type, including the draft/publish status when set
+func singleTypePath(singularAPIID, status string) string {
+	path := "/api/" + url.PathEscape(singularAPIID)
+	if status != "" {
+		path += "?status=" + url.QueryEscape(status)
+	}
+	return path
+}
+
+// GetSingleTypeEntry retrieves the document of a single type. The status selects the draft or published version
+// when draft and publish is enabled and may be left empty to use the Strapi default.
+func (c *StrapiClient) GetSingleTypeEntry(ctx context.Context, singularAPIID, status string) (*Entry, error) {
+	var result struct {
+		Data map[string]interface{} `json:"data"`
+	}
+
+	if err := c.doRequest(ctx, "GET", singleTypePath(singularAPIID, status), nil, &result, "get single type entry"); err != nil {
+		return nil, err
+	}
+
+	return newEntry(result.Data), nil
+}
+
+// PutSingleTypeEntry creates or updates the document of a single type
+func (c *StrapiClient) PutSingleTypeEntry(ctx context.Context, singularAPIID string, data map[string]interface{}, status string) (*Entry, error) {
+	payload := map[string]interface{}{
+		"data": data,
+	}
+
+	var result struct {
+		Data map[string]interface{} `json:"data"`
+	}
+
+	if err := c.doRequest(ctx, "PUT", singleTypePath(singularAPIID, status), payload, &result, "update single type entry"); err != nil {
+		return nil, err
+	}
+
+	return newEntry(result.Data), nil
+}
+
+// DeleteSingleTypeEntry deletes the document of a single type
+func (c *StrapiClient) DeleteSingleTypeEntry(ctx context.Context, singularAPIID string) error {
+	return c.doRequest(ctx, "DELETE", singleTypePath(singularAPIID, ""), nil, nil, "delete single type entry")
+}
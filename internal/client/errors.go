@@ -0,0 +1,100 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// APIError is returned when Strapi answers a request with a non-2xx status. Strapi v4 and v5 describe
+// errors with an envelope of the form {"error": {"status", "name", "message", "details"}}, which is
+// decoded when present.
+type APIError struct {
+	// Action describes the operation that failed, such as "get user"
+	Action string
+	// StatusCode is the HTTP status code of the response
+	StatusCode int
+	// Status is the HTTP status line of the response, such as "404 Not Found"
+	Status string
+	// Name is the Strapi error name, such as "NotFoundError" or "ValidationError"
+	Name string
+	// Message is the Strapi error message
+	Message string
+	// Details holds the additional error details, such as the list of validation errors
+	Details map[string]interface{}
+	// Body is the raw response body
+	Body string
+}
+
+func (e *APIError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("failed to %s: %s - %s", e.Action, e.Status, e.Body)
+	}
+
+	msg := fmt.Sprintf("failed to %s: %s - %s: %s", e.Action, e.Status, e.Name, e.Message)
+	if validation := e.validationErrors(); len(validation) > 0 {
+		msg += " (" + strings.Join(validation, "; ") + ")"
+	}
+	return msg
+}
+
+// validationErrors formats the validation errors listed in the details of a ValidationError
+func (e *APIError) validationErrors() []string {
+	entries, ok := e.Details["errors"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	var result []string
+	for _, entry := range entries {
+		fields, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		message, _ := fields["message"].(string)
+		if path, ok := fields["path"].([]interface{}); ok && len(path) > 0 {
+			parts := make([]string, len(path))
+			for i, part := range path {
+				parts[i] = fmt.Sprint(part)
+			}
+			message = strings.Join(parts, ".") + ": " + message
+		}
+		result = append(result, message)
+	}
+	return result
+}
+
+// newAPIError builds an APIError from an unsuccessful response and its body
+func newAPIError(action string, resp *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		Action:     action,
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Body:       string(body),
+	}
+
+	var envelope struct {
+		Error struct {
+			Name    string                 `json:"name"`
+			Message string                 `json:"message"`
+			Details map[string]interface{} `json:"details"`
+		} `json:"error"`
+	}
+
+	if err := json.Unmarshal(body, &envelope); err == nil {
+		apiErr.Name = envelope.Error.Name
+		apiErr.Message = envelope.Error.Message
+		apiErr.Details = envelope.Error.Details
+	}
+
+	return apiErr
+}
+
+// IsNotFound reports whether err is an APIError for a 404 response
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
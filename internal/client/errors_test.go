@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDoRequestAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/users/1":
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"data":null,"error":{"status":404,"name":"NotFoundError","message":"Not Found","details":{}}}`)
+		case "/api/users":
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"data":null,"error":{"status":400,"name":"ValidationError","message":"2 errors occurred",`+
+				`"details":{"errors":[{"path":["email"],"message":"email must be a valid email"},{"path":["username"],"message":"username is required"}]}}}`)
+		default:
+			w.WriteHeader(http.StatusBadGateway)
+			fmt.Fprint(w, "Bad Gateway")
+		}
+	}))
+	defer server.Close()
+
+	c := New(server.URL, "token")
+	c.MaxRetries = 0
+
+	_, err := c.GetUser(context.Background(), 1)
+	if !IsNotFound(err) {
+		t.Fatalf("expected a not found error, got: %v", err)
+	}
+
+	_, err = c.CreateUser(context.Background(), User{})
+	if IsNotFound(err) {
+		t.Fatalf("expected a validation error, got a not found error")
+	}
+	want := "failed to create user: 400 Bad Request - ValidationError: 2 errors occurred " +
+		"(email: email must be a valid email; username: username is required)"
+	if err == nil || err.Error() != want {
+		t.Fatalf("unexpected error:\n got: %v\nwant: %s", err, want)
+	}
+
+	_, err = c.GetRoles(context.Background())
+	want = "failed to get roles: 502 Bad Gateway - Bad Gateway"
+	if err == nil || err.Error() != want {
+		t.Fatalf("unexpected error:\n got: %v\nwant: %s", err, want)
+	}
+}
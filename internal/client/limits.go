@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+	"math"
+
+	"golang.org/x/time/rate"
+)
+
+// SetRateLimit limits the rate of requests sent to Strapi. Zero or a negative value removes the limit.
+// The limit applies to every attempt of every request made through the client.
+func (c *StrapiClient) SetRateLimit(requestsPerSecond float64) {
+	if requestsPerSecond <= 0 {
+		c.limiter = nil
+		return
+	}
+
+	burst := max(int(math.Ceil(requestsPerSecond)), 1)
+	c.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+}
+
+// SetMaxConcurrentRequests limits the number of requests in flight at the same time. Zero or a negative value
+// removes the limit.
+func (c *StrapiClient) SetMaxConcurrentRequests(n int) {
+	if n <= 0 {
+		c.inFlight = nil
+		return
+	}
+
+	c.inFlight = make(chan struct{}, n)
+}
+
+// acquire waits until a request may be sent under the rate limit and concurrency cap of the client, or until
+// ctx is done. The returned function must be called once the request has completed.
+func (c *StrapiClient) acquire(ctx context.Context) (func(), error) {
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.inFlight == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case c.inFlight <- struct{}{}:
+		return func() { <-c.inFlight }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
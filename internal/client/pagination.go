@@ -0,0 +1,31 @@
+package client
+
+import (
+	"iter"
+	"net/url"
+)
+
+// pageSize is the number of records requested per page when enumerating paginated collections.
+// It matches the default maximum page size of the Strapi REST API.
+const pageSize = 100
+
+// collect gathers every value of a paginated iterator, stopping at the first error
+func collect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	var result []T
+	for value, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, value)
+	}
+	return result, nil
+}
+
+// cloneQuery returns a copy of the query so that pagination parameters do not leak into the caller's values
+func cloneQuery(query url.Values) url.Values {
+	params := url.Values{}
+	for key, values := range query {
+		params[key] = append([]string(nil), values...)
+	}
+	return params
+}
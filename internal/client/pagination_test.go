@@ -0,0 +1,93 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+)
+
+func TestGetUsersPagination(t *testing.T) {
+	const total = 250
+
+	tests := []struct {
+		name         string
+		maxLimit     int
+		wantRequests int
+	}{
+		{name: "uncapped limit", wantRequests: 4},
+		{name: "limit capped by the server", maxLimit: 25, wantRequests: 11},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				start, _ := strconv.Atoi(r.URL.Query().Get("start"))
+				limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+				if tt.maxLimit > 0 {
+					limit = min(limit, tt.maxLimit)
+				}
+
+				if got := r.URL.Query().Get("filters[blocked][$eq]"); got != "false" {
+					t.Errorf("expected the query to be forwarded, got filter %q", got)
+				}
+
+				users := []User{}
+				for id := start + 1; id <= min(start+limit, total); id++ {
+					users = append(users, User{ID: id})
+				}
+				_ = json.NewEncoder(w).Encode(users)
+			}))
+			defer server.Close()
+
+			c := New(server.URL, "token")
+
+			var ids []int
+			for user, err := range c.Users(context.Background(), url.Values{"filters[blocked][$eq]": {"false"}}) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				ids = append(ids, user.ID)
+			}
+
+			if len(ids) != total || ids[0] != 1 || ids[total-1] != total {
+				t.Fatalf("expected %d users in order, got %d", total, len(ids))
+			}
+			if requests != tt.wantRequests {
+				t.Fatalf("expected %d page requests, got %d", tt.wantRequests, requests)
+			}
+		})
+	}
+}
+
+func TestGetAdminUsersPagination(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+
+		var result struct {
+			Data struct {
+				Results    []AdminUser    `json:"results"`
+				Pagination map[string]int `json:"pagination"`
+			} `json:"data"`
+		}
+		result.Data.Results = []AdminUser{{ID: page*2 - 1}, {ID: page * 2}}
+		result.Data.Pagination = map[string]int{"page": page, "pageSize": 2, "pageCount": 3, "total": 6}
+		_ = json.NewEncoder(w).Encode(result)
+	}))
+	defer server.Close()
+
+	c := New(server.URL, "token")
+
+	users, err := c.GetAdminUsers(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(users) != 6 || users[5].ID != 6 {
+		t.Fatalf("expected 6 admin users across 3 pages, got %+v", users)
+	}
+}
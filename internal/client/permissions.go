@@ -0,0 +1,131 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Permission represents the state of a single users-permissions action on a role
+type Permission struct {
+	Enabled bool   `json:"enabled"`
+	Policy  string `json:"policy"`
+}
+
+// FlattenPermissions converts the permissions tree of a role, as returned by the users-permissions plugin,
+// into a map keyed by action (e.g., 'api::article.article.find').
+func FlattenPermissions(tree map[string]interface{}) map[string]Permission {
+	permissions := make(map[string]Permission)
+
+	walkPermissions(tree, func(action string, node map[string]interface{}) {
+		permission := Permission{}
+		permission.Enabled, _ = node["enabled"].(bool)
+		permission.Policy, _ = node["policy"].(string)
+		permissions[action] = permission
+	})
+
+	return permissions
+}
+
+// ApplyPermissions updates the permissions tree of a role in place. When exclusive is true, every action
+// not listed in permissions is disabled. An error is returned for actions that do not exist in the tree.
+func ApplyPermissions(tree map[string]interface{}, permissions map[string]Permission, exclusive bool) error {
+	nodes := make(map[string]map[string]interface{})
+	walkPermissions(tree, func(action string, node map[string]interface{}) {
+		nodes[action] = node
+	})
+
+	var unknown []string
+	for action := range permissions {
+		if _, ok := nodes[action]; !ok {
+			unknown = append(unknown, action)
+		}
+	}
+
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("unknown permission actions: %s", strings.Join(unknown, ", "))
+	}
+
+	for action, node := range nodes {
+		permission, ok := permissions[action]
+		if !ok {
+			if !exclusive {
+				continue
+			}
+			permission = Permission{}
+		}
+
+		node["enabled"] = permission.Enabled
+		node["policy"] = permission.Policy
+	}
+
+	return nil
+}
+
+// walkPermissions calls fn for every action of a permissions tree. The tree is keyed by type
+// (e.g., 'api::article'), then 'controllers', then controller name and finally action name.
+func walkPermissions(tree map[string]interface{}, fn func(action string, node map[string]interface{})) {
+	for typeName, typeValue := range tree {
+		typeNode, ok := typeValue.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		controllers, ok := typeNode["controllers"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for controllerName, controllerValue := range controllers {
+			controller, ok := controllerValue.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			for actionName, actionValue := range controller {
+				node, ok := actionValue.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				fn(typeName+"."+controllerName+"."+actionName, node)
+			}
+		}
+	}
+}
+
+// UpdateRolePermissions applies permission changes to a users-permissions role with a read-modify-write of its
+// permissions tree. Actions not listed in permissions are left untouched. Concurrent updates to the same role are
+// serialized so that changes made by different resources are not lost.
+func (c *StrapiClient) UpdateRolePermissions(ctx context.Context, id int, permissions map[string]Permission) (*Role, error) {
+	unlock := c.lockRole(id)
+	defer unlock()
+
+	role, err := c.GetRole(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ApplyPermissions(role.Permissions, permissions, false); err != nil {
+		return nil, err
+	}
+
+	return c.UpdateRole(ctx, id, *role)
+}
+
+// lockRole acquires the lock guarding the permissions of a role and returns the function releasing it
+func (c *StrapiClient) lockRole(id int) func() {
+	c.roleLocksMu.Lock()
+	lock, ok := c.roleLocks[id]
+	if !ok {
+		lock = &sync.Mutex{}
+		c.roleLocks[id] = lock
+	}
+	c.roleLocksMu.Unlock()
+
+	lock.Lock()
+	return lock.Unlock
+}
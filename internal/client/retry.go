@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// retryBaseWait is the wait before the first retry, doubled on every following attempt
+const retryBaseWait = time.Second
+
+// maxBackoffShift is the first attempt that waits the maximum retry wait outright, as retryBaseWait<<attempt
+// eventually overflows time.Duration
+const maxBackoffShift = 30
+
+// shouldRetry reports whether a failed attempt is worth retrying. Rate limited requests are always retried since
+// Strapi did not process them. Connection errors and server errors are only retried for idempotent methods,
+// as a POST may have been applied before the failure. Cancelled and timed out requests are never retried.
+func shouldRetry(method string, resp *http.Response, err error) bool {
+	if err != nil {
+		// An API error at this point comes from the admin login, whose failure retrying does not fix.
+		var apiErr *APIError
+		if errors.As(err, &apiErr) {
+			return false
+		}
+		return isIdempotent(method) && !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return true
+	case resp.StatusCode >= http.StatusInternalServerError && resp.StatusCode != http.StatusNotImplemented:
+		return isIdempotent(method)
+	default:
+		return false
+	}
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryWait returns the wait before the next attempt. A Retry-After header is honored when present, otherwise
+// the wait grows exponentially with jitter. The wait never exceeds the maximum retry wait of the client.
+func (c *StrapiClient) retryWait(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return min(wait, c.RetryMaxWait)
+		}
+	}
+
+	backoff := c.RetryMaxWait
+	if attempt < maxBackoffShift {
+		backoff = min(retryBaseWait<<attempt, c.RetryMaxWait)
+	}
+	if backoff <= 0 {
+		return 0
+	}
+
+	// Randomizing half of the backoff spreads out clients retrying at the same time.
+	return backoff/2 + rand.N(backoff/2+1)
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as an HTTP date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0), true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
+
+// retryReason describes why an attempt failed, for logging
+func retryReason(resp *http.Response, err error) string {
+	if err != nil {
+		return err.Error()
+	}
+	return resp.Status
+}
+
+// sleep waits for the given duration or until ctx is done
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
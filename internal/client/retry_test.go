@@ -0,0 +1,128 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDoRequestRetries(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		statuses     []int
+		retryAfter   string
+		wantAttempts int32
+		wantErr      bool
+	}{
+		{name: "idempotent server error", method: "GET", statuses: []int{502, 503, 200}, wantAttempts: 3},
+		{name: "non-idempotent server error", method: "POST", statuses: []int{503, 200}, wantAttempts: 1, wantErr: true},
+		{name: "rate limited post", method: "POST", statuses: []int{429, 200}, retryAfter: "0", wantAttempts: 2},
+		{name: "client error", method: "PUT", statuses: []int{400, 200}, wantAttempts: 1, wantErr: true},
+		{name: "retries exhausted", method: "DELETE", statuses: []int{500, 500, 500}, wantAttempts: 3, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := attempts.Add(1)
+				if tt.retryAfter != "" {
+					w.Header().Set("Retry-After", tt.retryAfter)
+				}
+				w.WriteHeader(tt.statuses[n-1])
+			}))
+			defer server.Close()
+
+			c := New(server.URL, "token")
+			c.MaxRetries = 2
+			c.RetryMaxWait = time.Millisecond
+
+			err := c.doRequest(context.Background(), tt.method, "/api/test", map[string]string{"a": "b"}, nil, "test")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := attempts.Load(); got != tt.wantAttempts {
+				t.Fatalf("expected %d attempts, got %d", tt.wantAttempts, got)
+			}
+		})
+	}
+}
+
+func TestDoRequestAbortsHungRequest(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestTimeout time.Duration
+		cancelAfter    time.Duration
+		wantErr        error
+	}{
+		{name: "context cancelled", cancelAfter: 50 * time.Millisecond, wantErr: context.Canceled},
+		{name: "request timeout", requestTimeout: 50 * time.Millisecond, wantErr: context.DeadlineExceeded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts.Add(1)
+				<-r.Context().Done()
+			}))
+			defer server.Close()
+
+			c := New(server.URL, "token")
+			c.MaxRetries = 3
+			c.RetryMaxWait = time.Millisecond
+			c.RequestTimeout = tt.requestTimeout
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.cancelAfter > 0 {
+				time.AfterFunc(tt.cancelAfter, cancel)
+			}
+
+			start := time.Now()
+			err := c.doRequest(ctx, "GET", "/api/test", nil, nil, "test")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if elapsed := time.Since(start); elapsed > time.Second {
+				t.Fatalf("expected the hung request to be aborted promptly, took %s", elapsed)
+			}
+			if got := attempts.Load(); got != 1 {
+				t.Fatalf("expected no retries after the request was aborted, got %d attempts", got)
+			}
+		})
+	}
+}
+
+func TestRetryWait(t *testing.T) {
+	c := New("http://localhost", "token")
+	c.RetryMaxWait = 10 * time.Second
+
+	for attempt := 0; attempt < 6; attempt++ {
+		backoff := min(retryBaseWait<<attempt, c.RetryMaxWait)
+		if wait := c.retryWait(attempt, nil); wait < backoff/2 || wait > backoff {
+			t.Fatalf("attempt %d: wait %s outside of [%s, %s]", attempt, wait, backoff/2, backoff)
+		}
+	}
+
+	for _, attempt := range []int{maxBackoffShift, 34, 63, 64, 1000} {
+		if wait := c.retryWait(attempt, nil); wait < c.RetryMaxWait/2 || wait > c.RetryMaxWait {
+			t.Fatalf("attempt %d: wait %s outside of [%s, %s]", attempt, wait, c.RetryMaxWait/2, c.RetryMaxWait)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"5"}}}
+	if wait := c.retryWait(0, resp); wait != 5*time.Second {
+		t.Fatalf("expected Retry-After to be honored, got %s", wait)
+	}
+
+	resp.Header.Set("Retry-After", "120")
+	if wait := c.retryWait(0, resp); wait != c.RetryMaxWait {
+		t.Fatalf("expected Retry-After to be capped at %s, got %s", c.RetryMaxWait, wait)
+	}
+}
@@ -0,0 +1,91 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+)
+
+// TransferToken represents a Strapi data transfer token
+type TransferToken struct {
+	ID          int         `json:"id"`
+	Name        string      `json:"name"`
+	Description string      `json:"description,omitempty"`
+	AccessKey   string      `json:"accessKey,omitempty"`
+	Lifespan    json.Number `json:"lifespan,omitempty"`
+	ExpiresAt   string      `json:"expiresAt,omitempty"`
+	LastUsedAt  string      `json:"lastUsedAt,omitempty"`
+	Permissions []string    `json:"permissions"`
+	CreatedAt   string      `json:"createdAt,omitempty"`
+	UpdatedAt   string      `json:"updatedAt,omitempty"`
+}
+
+// GetTransferToken retrieves a transfer token by ID
+func (c *StrapiClient) GetTransferToken(ctx context.Context, id int) (*TransferToken, error) {
+	var result struct {
+		Data TransferToken `json:"data"`
+	}
+
+	if err := c.doRequest(ctx, "GET", "/admin/transfer/tokens/"+strconv.Itoa(id), nil, &result, "get transfer token"); err != nil {
+		return nil, err
+	}
+
+	return &result.Data, nil
+}
+
+// CreateTransferToken creates a new transfer token. The returned token holds the access key.
+func (c *StrapiClient) CreateTransferToken(ctx context.Context, token TransferToken) (*TransferToken, error) {
+	payload := map[string]interface{}{
+		"name":        token.Name,
+		"description": token.Description,
+		"lifespan":    tokenLifespan(token.Lifespan),
+		"permissions": tokenPermissions(token.Permissions),
+	}
+
+	var result struct {
+		Data TransferToken `json:"data"`
+	}
+
+	if err := c.doRequest(ctx, "POST", "/admin/transfer/tokens", payload, &result, "create transfer token"); err != nil {
+		return nil, err
+	}
+
+	return &result.Data, nil
+}
+
+// UpdateTransferToken updates an existing transfer token. The lifespan of a token cannot be changed.
+func (c *StrapiClient) UpdateTransferToken(ctx context.Context, id int, token TransferToken) (*TransferToken, error) {
+	payload := map[string]interface{}{
+		"name":        token.Name,
+		"description": token.Description,
+		"permissions": tokenPermissions(token.Permissions),
+	}
+
+	var result struct {
+		Data TransferToken `json:"data"`
+	}
+
+	if err := c.doRequest(ctx, "PUT", "/admin/transfer/tokens/"+strconv.Itoa(id), payload, &result, "update transfer token"); err != nil {
+		return nil, err
+	}
+
+	return &result.Data, nil
+}
+
+// RegenerateTransferToken replaces the access key of a transfer token. The returned token holds the new access key.
+func (c *StrapiClient) RegenerateTransferToken(ctx context.Context, id int) (*TransferToken, error) {
+	var result struct {
+		Data TransferToken `json:"data"`
+	}
+
+	if err := c.doRequest(ctx, "POST", "/admin/transfer/tokens/"+strconv.Itoa(id)+"/regenerate", nil, &result, "regenerate transfer token"); err != nil {
+		return nil, err
+	}
+
+	return &result.Data, nil
+}
+
+// DeleteTransferToken deletes a transfer token
+func (c *StrapiClient) DeleteTransferToken(ctx context.Context, id int) error {
+	return c.doRequest(ctx, "DELETE", "/admin/transfer/tokens/"+strconv.Itoa(id), nil, nil, "delete transfer token")
+}
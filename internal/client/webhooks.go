@@ -0,0 +1,96 @@
+package client
+
+import (
+	"context"
+	"strconv"
+)
+
+// Webhook represents a Strapi webhook
+type Webhook struct {
+	ID        int               `json:"id"`
+	Name      string            `json:"name"`
+	URL       string            `json:"url"`
+	Headers   map[string]string `json:"headers"`
+	Events    []string          `json:"events"`
+	IsEnabled bool              `json:"isEnabled"`
+}
+
+// GetWebhooks retrieves all webhooks from Strapi
+func (c *StrapiClient) GetWebhooks(ctx context.Context) ([]Webhook, error) {
+	var result struct {
+		Data []Webhook `json:"data"`
+	}
+
+	if err := c.doRequest(ctx, "GET", "/admin/webhooks", nil, &result, "get webhooks"); err != nil {
+		return nil, err
+	}
+
+	return result.Data, nil
+}
+
+// GetWebhook retrieves a webhook by ID
+func (c *StrapiClient) GetWebhook(ctx context.Context, id int) (*Webhook, error) {
+	var result struct {
+		Data Webhook `json:"data"`
+	}
+
+	if err := c.doRequest(ctx, "GET", "/admin/webhooks/"+strconv.Itoa(id), nil, &result, "get webhook"); err != nil {
+		return nil, err
+	}
+
+	return &result.Data, nil
+}
+
+// CreateWebhook creates a new webhook. Strapi enables new webhooks, so the enabled flag is not sent.
+func (c *StrapiClient) CreateWebhook(ctx context.Context, webhook Webhook) (*Webhook, error) {
+	payload := map[string]interface{}{
+		"name":    webhook.Name,
+		"url":     webhook.URL,
+		"headers": webhookHeaders(webhook.Headers),
+		"events":  webhook.Events,
+	}
+
+	var result struct {
+		Data Webhook `json:"data"`
+	}
+
+	if err := c.doRequest(ctx, "POST", "/admin/webhooks", payload, &result, "create webhook"); err != nil {
+		return nil, err
+	}
+
+	return &result.Data, nil
+}
+
+// UpdateWebhook updates an existing webhook
+func (c *StrapiClient) UpdateWebhook(ctx context.Context, id int, webhook Webhook) (*Webhook, error) {
+	payload := map[string]interface{}{
+		"name":      webhook.Name,
+		"url":       webhook.URL,
+		"headers":   webhookHeaders(webhook.Headers),
+		"events":    webhook.Events,
+		"isEnabled": webhook.IsEnabled,
+	}
+
+	var result struct {
+		Data Webhook `json:"data"`
+	}
+
+	if err := c.doRequest(ctx, "PUT", "/admin/webhooks/"+strconv.Itoa(id), payload, &result, "update webhook"); err != nil {
+		return nil, err
+	}
+
+	return &result.Data, nil
+}
+
+// DeleteWebhook deletes a webhook
+func (c *StrapiClient) DeleteWebhook(ctx context.Context, id int) error {
+	return c.doRequest(ctx, "DELETE", "/admin/webhooks/"+strconv.Itoa(id), nil, nil, "delete webhook")
+}
+
+// webhookHeaders returns the headers to send to Strapi, which expects an object even when there are no headers
+func webhookHeaders(headers map[string]string) map[string]string {
+	if headers == nil {
+		return map[string]string{}
+	}
+	return headers
+}
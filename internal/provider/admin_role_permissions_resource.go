@@ -0,0 +1,332 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fbritoferreira/terraform-provider-strapi/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &AdminRolePermissionsResource{}
+var _ resource.ResourceWithImportState = &AdminRolePermissionsResource{}
+
+type AdminRolePermissionsResource struct {
+	client *client.StrapiClient
+}
+
+type AdminRolePermissionsResourceModel struct {
+	ID          types.String           `tfsdk:"id"`
+	RoleID      types.String           `tfsdk:"role_id"`
+	Permissions []AdminPermissionModel `tfsdk:"permissions"`
+}
+
+type AdminPermissionModel struct {
+	Action     types.String   `tfsdk:"action"`
+	Subject    types.String   `tfsdk:"subject"`
+	Fields     []types.String `tfsdk:"fields"`
+	Locales    []types.String `tfsdk:"locales"`
+	Conditions []types.String `tfsdk:"conditions"`
+}
+
+func NewAdminRolePermissionsResource() resource.Resource {
+	return &AdminRolePermissionsResource{}
+}
+
+func (r *AdminRolePermissionsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_admin_role_permissions"
+}
+
+func (r *AdminRolePermissionsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the full permission set of a Strapi admin role. Permissions not listed here are removed from the role.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the admin role.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the admin role. Changing this forces a new resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permissions": schema.SetNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "The permissions of the admin role.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"action": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The action (e.g., 'plugin::content-manager.explorer.read').",
+						},
+						"subject": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The subject the action applies to (e.g., 'api::article.article'). Omit for actions without a subject.",
+						},
+						"fields": schema.SetAttribute{
+							Optional:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The fields of the subject the action is restricted to.",
+						},
+						"locales": schema.SetAttribute{
+							Optional:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The locales the action is restricted to.",
+						},
+						"conditions": schema.SetAttribute{
+							Optional:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The conditions applied to the action (e.g., 'admin::is-creator').",
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *AdminRolePermissionsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.StrapiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.StrapiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AdminRolePermissionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AdminRolePermissionsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleID, err := strconv.Atoi(plan.RoleID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing admin role ID",
+			fmt.Sprintf("Could not parse admin role ID '%s': %s", plan.RoleID.ValueString(), err),
+		)
+		return
+	}
+
+	_, err = r.client.UpdateAdminRolePermissions(ctx, roleID, expandAdminPermissions(plan.Permissions))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error setting admin role permissions",
+			fmt.Sprintf("Could not set admin role permissions: %s", err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.Itoa(roleID))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Set %d permissions on admin role with ID: %d", len(plan.Permissions), roleID))
+}
+
+func (r *AdminRolePermissionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AdminRolePermissionsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleID, err := strconv.Atoi(state.RoleID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing admin role ID",
+			fmt.Sprintf("Could not parse admin role ID '%s': %s", state.RoleID.ValueString(), err),
+		)
+		return
+	}
+
+	permissions, err := r.client.GetAdminRolePermissions(ctx, roleID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Admin role with ID %d not found, removing its permissions from state", roleID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading admin role permissions",
+			fmt.Sprintf("Could not read admin role permissions: %s", err),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(strconv.Itoa(roleID))
+	state.Permissions = flattenAdminPermissions(permissions)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Read %d permissions of admin role with ID: %d", len(permissions), roleID))
+}
+
+func (r *AdminRolePermissionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan AdminRolePermissionsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleID, err := strconv.Atoi(plan.RoleID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing admin role ID",
+			fmt.Sprintf("Could not parse admin role ID '%s': %s", plan.RoleID.ValueString(), err),
+		)
+		return
+	}
+
+	_, err = r.client.UpdateAdminRolePermissions(ctx, roleID, expandAdminPermissions(plan.Permissions))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating admin role permissions",
+			fmt.Sprintf("Could not update admin role permissions: %s", err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Updated permissions of admin role with ID: %d", roleID))
+}
+
+func (r *AdminRolePermissionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AdminRolePermissionsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleID, err := strconv.Atoi(state.RoleID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing admin role ID",
+			fmt.Sprintf("Could not parse admin role ID '%s': %s", state.RoleID.ValueString(), err),
+		)
+		return
+	}
+
+	_, err = r.client.UpdateAdminRolePermissions(ctx, roleID, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting admin role permissions",
+			fmt.Sprintf("Could not remove admin role permissions: %s", err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Removed permissions of admin role with ID: %d", roleID))
+}
+
+func (r *AdminRolePermissionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_id"), req.ID)...)
+}
+
+func expandAdminPermissions(models []AdminPermissionModel) []client.AdminPermission {
+	permissions := make([]client.AdminPermission, len(models))
+
+	for i, model := range models {
+		properties := map[string]interface{}{}
+		if model.Fields != nil {
+			properties["fields"] = stringValues(model.Fields)
+		}
+		if model.Locales != nil {
+			properties["locales"] = stringValues(model.Locales)
+		}
+
+		conditions := stringValues(model.Conditions)
+		if conditions == nil {
+			conditions = []string{}
+		}
+
+		permissions[i] = client.AdminPermission{
+			Action:     model.Action.ValueString(),
+			Subject:    model.Subject.ValueStringPointer(),
+			Properties: properties,
+			Conditions: conditions,
+		}
+	}
+
+	return permissions
+}
+
+// flattenAdminPermissions converts the permissions of an admin role into their Terraform representation.
+// Properties missing from the response and empty condition lists are represented as null.
+func flattenAdminPermissions(permissions []client.AdminPermission) []AdminPermissionModel {
+	models := make([]AdminPermissionModel, len(permissions))
+
+	for i, permission := range permissions {
+		models[i] = AdminPermissionModel{
+			Action:     types.StringValue(permission.Action),
+			Subject:    types.StringPointerValue(permission.Subject),
+			Fields:     propertyValues(permission.Properties, "fields"),
+			Locales:    propertyValues(permission.Properties, "locales"),
+			Conditions: optionalStringValues(permission.Conditions, nil),
+		}
+	}
+
+	return models
+}
+
+// propertyValues extracts a list of strings from the properties of an admin permission.
+func propertyValues(properties map[string]interface{}, key string) []types.String {
+	raw, ok := properties[key].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	values := make([]types.String, 0, len(raw))
+	for _, value := range raw {
+		if s, ok := value.(string); ok {
+			values = append(values, types.StringValue(s))
+		}
+	}
+	return values
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAdminRolePermissionsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdminRolePermissionsResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("strapi_admin_role_permissions.test", "permissions.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("strapi_admin_role_permissions.test", "permissions.*", map[string]string{
+						"action": "plugin::upload.read",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("strapi_admin_role_permissions.test", "permissions.*", map[string]string{
+						"action":       "plugin::upload.assets.update",
+						"conditions.#": "1",
+					}),
+				),
+			},
+			{
+				ResourceName:      "strapi_admin_role_permissions.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccAdminRolePermissionsResourceConfig = `
+resource "strapi_admin_role" "test" {
+  name        = "Terraform Permissions Reviewer"
+  description = "Role used by the admin role permissions acceptance test"
+}
+
+resource "strapi_admin_role_permissions" "test" {
+  role_id = strapi_admin_role.test.id
+
+  permissions = [
+    {
+      action = "plugin::upload.read"
+    },
+    {
+      action     = "plugin::upload.assets.update"
+      conditions = ["admin::is-creator"]
+    },
+  ]
+}
+`
@@ -0,0 +1,265 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fbritoferreira/terraform-provider-strapi/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &AdminRoleResource{}
+var _ resource.ResourceWithImportState = &AdminRoleResource{}
+
+type AdminRoleResource struct {
+	client *client.StrapiClient
+}
+
+type AdminRoleResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Code        types.String `tfsdk:"code"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+func NewAdminRoleResource() resource.Resource {
+	return &AdminRoleResource{}
+}
+
+func (r *AdminRoleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_admin_role"
+}
+
+func (r *AdminRoleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Strapi admin panel role.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the admin role.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the admin role.",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The description of the admin role.",
+			},
+			"code": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The code of the admin role, generated by Strapi from the name (e.g., 'strapi-editor').",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The creation timestamp of the admin role.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The last update timestamp of the admin role.",
+			},
+		},
+	}
+}
+
+func (r *AdminRoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.StrapiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.StrapiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AdminRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AdminRoleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role := client.AdminRole{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+	}
+
+	createdRole, err := r.client.CreateAdminRole(ctx, role)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating admin role",
+			fmt.Sprintf("Could not create admin role: %s", err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.Itoa(createdRole.ID))
+	plan.Name = types.StringValue(createdRole.Name)
+	plan.Description = types.StringValue(createdRole.Description)
+	plan.Code = types.StringValue(createdRole.Code)
+	plan.CreatedAt = types.StringValue(createdRole.CreatedAt)
+	plan.UpdatedAt = types.StringValue(createdRole.UpdatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Created admin role with ID: %d", createdRole.ID))
+}
+
+func (r *AdminRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AdminRoleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing admin role ID",
+			fmt.Sprintf("Could not parse admin role ID '%s': %s", state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	role, err := r.client.GetAdminRole(ctx, id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Admin role with ID %d not found, removing from state", id))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading admin role",
+			fmt.Sprintf("Could not read admin role: %s", err),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(strconv.Itoa(role.ID))
+	state.Name = types.StringValue(role.Name)
+	state.Description = types.StringValue(role.Description)
+	state.Code = types.StringValue(role.Code)
+	state.CreatedAt = types.StringValue(role.CreatedAt)
+	state.UpdatedAt = types.StringValue(role.UpdatedAt)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Read admin role with ID: %d", role.ID))
+}
+
+func (r *AdminRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan AdminRoleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing admin role ID",
+			fmt.Sprintf("Could not parse admin role ID '%s': %s", plan.ID.ValueString(), err),
+		)
+		return
+	}
+
+	role := client.AdminRole{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+	}
+
+	updatedRole, err := r.client.UpdateAdminRole(ctx, id, role)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating admin role",
+			fmt.Sprintf("Could not update admin role: %s", err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.Itoa(updatedRole.ID))
+	plan.Name = types.StringValue(updatedRole.Name)
+	plan.Description = types.StringValue(updatedRole.Description)
+	plan.Code = types.StringValue(updatedRole.Code)
+	plan.CreatedAt = types.StringValue(updatedRole.CreatedAt)
+	plan.UpdatedAt = types.StringValue(updatedRole.UpdatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Updated admin role with ID: %d", updatedRole.ID))
+}
+
+func (r *AdminRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AdminRoleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing admin role ID",
+			fmt.Sprintf("Could not parse admin role ID '%s': %s", state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	err = r.client.DeleteAdminRole(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting admin role",
+			fmt.Sprintf("Could not delete admin role: %s", err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Deleted admin role with ID: %d", id))
+}
+
+func (r *AdminRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
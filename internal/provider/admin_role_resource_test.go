@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAdminRoleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdminRoleResourceConfig("Terraform Reviewer", "Reviews content"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("strapi_admin_role.test", "name", "Terraform Reviewer"),
+					resource.TestCheckResourceAttr("strapi_admin_role.test", "description", "Reviews content"),
+					resource.TestCheckResourceAttrSet("strapi_admin_role.test", "code"),
+					resource.TestCheckResourceAttrSet("strapi_admin_role.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "strapi_admin_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAdminRoleResourceConfig("Terraform Reviewer", "Reviews and approves content"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("strapi_admin_role.test", "description", "Reviews and approves content"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAdminRoleResourceConfig(name, description string) string {
+	return fmt.Sprintf(`
+resource "strapi_admin_role" "test" {
+  name        = %[1]q
+  description = %[2]q
+}
+`, name, description)
+}
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fbritoferreira/terraform-provider-strapi/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &AdminRolesDataSource{}
+
+type AdminRolesDataSource struct {
+	client *client.StrapiClient
+}
+
+type AdminRolesDataSourceModel struct {
+	Roles []AdminRoleModel `tfsdk:"roles"`
+}
+
+type AdminRoleModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Code        types.String `tfsdk:"code"`
+	Description types.String `tfsdk:"description"`
+	UsersCount  types.Int64  `tfsdk:"users_count"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+func NewAdminRolesDataSource() datasource.DataSource {
+	return &AdminRolesDataSource{}
+}
+
+func (d *AdminRolesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_admin_roles"
+}
+
+func (d *AdminRolesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all Strapi admin panel roles available in your instance.",
+		Blocks: map[string]schema.Block{
+			"roles": schema.ListNestedBlock{
+				Description: "List of admin roles",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the admin role.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the admin role.",
+							Computed:    true,
+						},
+						"code": schema.StringAttribute{
+							Description: "The code of the admin role (e.g., 'strapi-super-admin', 'strapi-editor').",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the admin role.",
+							Computed:    true,
+						},
+						"users_count": schema.Int64Attribute{
+							Description: "The number of admin users assigned to the role.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "The creation timestamp of the admin role.",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "The last update timestamp of the admin role.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AdminRolesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.StrapiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.StrapiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AdminRolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state AdminRolesDataSourceModel
+
+	roles, err := d.client.GetAdminRoles(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading admin roles",
+			fmt.Sprintf("Could not read admin roles: %s", err),
+		)
+		return
+	}
+
+	roleModels := make([]AdminRoleModel, len(roles))
+	for i, role := range roles {
+		roleModels[i] = AdminRoleModel{
+			ID:          types.StringValue(strconv.Itoa(role.ID)),
+			Name:        types.StringValue(role.Name),
+			Code:        types.StringValue(role.Code),
+			Description: types.StringValue(role.Description),
+			UsersCount:  types.Int64Value(int64(role.UsersCount)),
+			CreatedAt:   types.StringValue(role.CreatedAt),
+			UpdatedAt:   types.StringValue(role.UpdatedAt),
+		}
+	}
+
+	state.Roles = roleModels
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Read %d admin roles", len(roles)))
+}
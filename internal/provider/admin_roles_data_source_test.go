@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAdminRolesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdminRolesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.strapi_admin_roles.test", "roles.#"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.strapi_admin_roles.test", "roles.*", map[string]string{
+						"code": "strapi-super-admin",
+						"name": "Super Admin",
+					}),
+				),
+			},
+		},
+	})
+}
+
+const testAccAdminRolesDataSourceConfig = `
+data "strapi_admin_roles" "test" {}
+`
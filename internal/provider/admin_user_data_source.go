@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fbritoferreira/terraform-provider-strapi/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &AdminUserDataSource{}
+
+type AdminUserDataSource struct {
+	client *client.StrapiClient
+}
+
+type AdminUserDataSourceModel struct {
+	ID               types.String   `tfsdk:"id"`
+	Email            types.String   `tfsdk:"email"`
+	Firstname        types.String   `tfsdk:"firstname"`
+	Lastname         types.String   `tfsdk:"lastname"`
+	Username         types.String   `tfsdk:"username"`
+	IsActive         types.Bool     `tfsdk:"is_active"`
+	Blocked          types.Bool     `tfsdk:"blocked"`
+	PreferedLanguage types.String   `tfsdk:"prefered_language"`
+	Roles            []types.Int64  `tfsdk:"roles"`
+	RoleNames        []types.String `tfsdk:"role_names"`
+	RoleCodes        []types.String `tfsdk:"role_codes"`
+}
+
+func NewAdminUserDataSource() datasource.DataSource {
+	return &AdminUserDataSource{}
+}
+
+func (d *AdminUserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_admin_user"
+}
+
+func (d *AdminUserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a single Strapi admin panel user by email.",
+		Attributes: map[string]schema.Attribute{
+			"email": schema.StringAttribute{
+				Description: "The email address of the admin user. The lookup is case-insensitive.",
+				Required:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The ID of the admin user.",
+				Computed:    true,
+			},
+			"firstname": schema.StringAttribute{
+				Description: "The first name of the admin user.",
+				Computed:    true,
+			},
+			"lastname": schema.StringAttribute{
+				Description: "The last name of the admin user.",
+				Computed:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "The username of the admin user.",
+				Computed:    true,
+			},
+			"is_active": schema.BoolAttribute{
+				Description: "Whether the admin user is active.",
+				Computed:    true,
+			},
+			"blocked": schema.BoolAttribute{
+				Description: "Whether the admin user is blocked.",
+				Computed:    true,
+			},
+			"prefered_language": schema.StringAttribute{
+				Description: "The preferred language of the admin user.",
+				Computed:    true,
+			},
+			"roles": schema.ListAttribute{
+				Description: "The IDs of the admin roles assigned to the user.",
+				Computed:    true,
+				ElementType: types.Int64Type,
+			},
+			"role_names": schema.ListAttribute{
+				Description: "The names of the admin roles assigned to the user.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"role_codes": schema.ListAttribute{
+				Description: "The codes of the admin roles assigned to the user (e.g., 'strapi-editor').",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (d *AdminUserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.StrapiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.StrapiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AdminUserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config AdminUserDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := d.client.FindAdminUserByEmail(ctx, config.Email.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading admin user",
+			fmt.Sprintf("Could not find admin user with email '%s': %s", config.Email.ValueString(), err),
+		)
+		return
+	}
+
+	state := AdminUserDataSourceModel{
+		ID:               types.StringValue(strconv.Itoa(user.ID)),
+		Email:            types.StringValue(user.Email),
+		Firstname:        types.StringValue(user.Firstname),
+		Lastname:         types.StringValue(user.Lastname),
+		Username:         types.StringValue(user.Username),
+		IsActive:         types.BoolPointerValue(user.IsActive),
+		Blocked:          types.BoolValue(user.Blocked),
+		PreferedLanguage: types.StringValue(user.PreferedLanguage),
+		Roles:            make([]types.Int64, len(user.Roles)),
+		RoleNames:        make([]types.String, len(user.AssignedRoles)),
+		RoleCodes:        make([]types.String, len(user.AssignedRoles)),
+	}
+
+	for i, id := range user.Roles {
+		state.Roles[i] = types.Int64Value(int64(id))
+	}
+	for i, role := range user.AssignedRoles {
+		state.RoleNames[i] = types.StringValue(role.Name)
+		state.RoleCodes[i] = types.StringValue(role.Code)
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Read admin user with ID: %d", user.ID))
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAdminUserDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdminUserDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.strapi_admin_user.test", "id", "strapi_admin_user.test", "id"),
+					resource.TestCheckResourceAttr("data.strapi_admin_user.test", "firstname", "Lookup"),
+					resource.TestCheckResourceAttr("data.strapi_admin_user.test", "lastname", "Admin"),
+					resource.TestCheckResourceAttr("data.strapi_admin_user.test", "roles.#", "1"),
+					resource.TestCheckResourceAttr("data.strapi_admin_user.test", "role_codes.0", "strapi-editor"),
+				),
+			},
+		},
+	})
+}
+
+const testAccAdminUserDataSourceConfig = `
+data "strapi_admin_roles" "all" {}
+
+resource "strapi_admin_user" "test" {
+  email     = "tf-lookup-admin@example.com"
+  firstname = "Lookup"
+  lastname  = "Admin"
+  password  = "TestPass123!"
+  roles     = [for role in data.strapi_admin_roles.all.roles : tonumber(role.id) if role.code == "strapi-editor"]
+}
+
+data "strapi_admin_user" "test" {
+  email = upper(strapi_admin_user.test.email)
+}
+`
@@ -4,18 +4,27 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/fbritoferreira/terraform-provider-strapi/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = &AdminUserResource{}
+var _ resource.ResourceWithConfigValidators = &AdminUserResource{}
+var _ resource.ResourceWithModifyPlan = &AdminUserResource{}
 
 type AdminUserResource struct {
 	client *client.StrapiClient
@@ -27,8 +36,10 @@ type AdminUserResourceModel struct {
 	Firstname         types.String `tfsdk:"firstname"`
 	Lastname          types.String `tfsdk:"lastname"`
 	Password          types.String `tfsdk:"password"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
 	IsActive          types.Bool   `tfsdk:"is_active"`
 	Roles             types.List   `tfsdk:"roles"`
+	RoleNames         types.Set    `tfsdk:"role_names"`
 	PreferedLanguage  types.String `tfsdk:"prefered_language"`
 	RegistrationToken types.String `tfsdk:"registration_token"`
 }
@@ -68,7 +79,15 @@ func (r *AdminUserResource) Schema(ctx context.Context, req resource.SchemaReque
 			"password": schema.StringAttribute{
 				Optional:            true,
 				Sensitive:           true,
-				MarkdownDescription: "The password for the admin user. Required for create, optional for update. Must be at least 8 characters with 1 uppercase, 1 lowercase, and 1 digit.",
+				WriteOnly:           true,
+				MarkdownDescription: "The password for the admin user. Must be at least 8 characters with 1 uppercase, 1 lowercase, and 1 digit. This value is write-only and never stored in state; it is only sent on create and when `password_wo_version` changes.",
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "A version number for `password`. Change it to send the current `password` to Strapi and rotate the password.",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password")),
+				},
 			},
 			"is_active": schema.BoolAttribute{
 				Optional:            true,
@@ -76,9 +95,21 @@ func (r *AdminUserResource) Schema(ctx context.Context, req resource.SchemaReque
 				MarkdownDescription: "Whether the admin user account is active.",
 			},
 			"roles": schema.ListAttribute{
-				Required:            true,
-				MarkdownDescription: "List of role IDs assigned to the admin user.",
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "List of role IDs assigned to the admin user. Exactly one of `roles` or `role_names` must be set.",
 				ElementType:         types.Int64Type,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_names": schema.SetAttribute{
+				Optional:            true,
+				MarkdownDescription: "Names of the admin roles assigned to the admin user (e.g., 'Editor'), resolved to IDs at apply time. Exactly one of `roles` or `role_names` must be set.",
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
 			},
 			"prefered_language": schema.StringAttribute{
 				Optional:            true,
@@ -94,6 +125,15 @@ func (r *AdminUserResource) Schema(ctx context.Context, req resource.SchemaReque
 	}
 }
 
+func (r *AdminUserResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("roles"),
+			path.MatchRoot("role_names"),
+		),
+	}
+}
+
 func (r *AdminUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -111,6 +151,24 @@ func (r *AdminUserResource) Configure(ctx context.Context, req resource.Configur
 	r.client = client
 }
 
+func (r *AdminUserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state AdminUserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The role IDs are resolved from the names at apply time, so they are only known once the names stay the same
+	if !plan.RoleNames.IsNull() && !plan.RoleNames.Equal(state.RoleNames) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("roles"), types.ListUnknown(types.Int64Type))...)
+	}
+}
+
 func (r *AdminUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan AdminUserResourceModel
 	diags := req.Config.Get(ctx, &plan)
@@ -119,8 +177,7 @@ func (r *AdminUserResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	roles := []int{}
-	diags = plan.Roles.ElementsAs(ctx, &roles, false)
+	roles, diags := r.resolveRoles(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -138,7 +195,7 @@ func (r *AdminUserResource) Create(ctx context.Context, req resource.CreateReque
 		adminUser.Password = plan.Password.ValueString()
 	}
 
-	createdUser, err := r.client.CreateAdminUser(adminUser)
+	createdUser, err := r.client.CreateAdminUser(ctx, adminUser)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating admin user",
@@ -189,8 +246,14 @@ func (r *AdminUserResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	user, err := r.client.GetAdminUser(id)
+	user, err := r.client.GetAdminUser(ctx, id)
 	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Admin user with ID %d not found, removing from state", id))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error reading admin user",
 			fmt.Sprintf("Could not read admin user: %s", err),
@@ -212,6 +275,11 @@ func (r *AdminUserResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 	state.Roles = roleList
+	state.RoleNames, diags = flattenAdminRoleNames(ctx, user.AssignedRoles, state.RoleNames)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -223,9 +291,10 @@ func (r *AdminUserResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *AdminUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan AdminUserResourceModel
+	var plan, state AdminUserResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -239,8 +308,7 @@ func (r *AdminUserResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	roles := []int{}
-	diags = plan.Roles.ElementsAs(ctx, &roles, false)
+	roles, diags := r.resolveRoles(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -259,11 +327,17 @@ func (r *AdminUserResource) Update(ctx context.Context, req resource.UpdateReque
 		adminUser.IsActive = &isActive
 	}
 
-	if !plan.Password.IsNull() {
-		adminUser.Password = plan.Password.ValueString()
+	// The password is write-only, so it is read from the configuration and only sent when its version changes
+	if !plan.PasswordWOVersion.Equal(state.PasswordWOVersion) {
+		var password types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		adminUser.Password = password.ValueString()
 	}
 
-	updatedUser, err := r.client.UpdateAdminUser(id, adminUser)
+	updatedUser, err := r.client.UpdateAdminUser(ctx, id, adminUser)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating admin user",
@@ -313,7 +387,7 @@ func (r *AdminUserResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
-	err = r.client.DeleteAdminUser(id)
+	err = r.client.DeleteAdminUser(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting admin user",
@@ -329,6 +403,64 @@ func (r *AdminUserResource) ImportState(ctx context.Context, req resource.Import
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// resolveRoles returns the IDs of the roles to assign, looking them up by name when role_names is set.
+func (r *AdminUserResource) resolveRoles(ctx context.Context, plan AdminUserResourceModel) ([]int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	roles := []int{}
+
+	if plan.RoleNames.IsNull() {
+		diags.Append(plan.Roles.ElementsAs(ctx, &roles, false)...)
+		return roles, diags
+	}
+
+	var names []string
+	diags.Append(plan.RoleNames.ElementsAs(ctx, &names, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, name := range names {
+		role, err := r.client.FindAdminRoleByName(ctx, name)
+		if err != nil {
+			diags.AddError(
+				"Error finding admin role",
+				fmt.Sprintf("Could not find admin role '%s': %s", name, err),
+			)
+			return nil, diags
+		}
+		roles = append(roles, role.ID)
+	}
+
+	return roles, diags
+}
+
+// flattenAdminRoleNames converts the roles assigned to an admin user into the role names kept in state. The names
+// are only tracked when they were set before, and the configured spelling is kept for names matching case-insensitively.
+func flattenAdminRoleNames(ctx context.Context, assigned []client.AdminRole, prior types.Set) (types.Set, diag.Diagnostics) {
+	if prior.IsNull() || prior.IsUnknown() || len(assigned) == 0 {
+		return prior, nil
+	}
+
+	var priorNames []string
+	diags := prior.ElementsAs(ctx, &priorNames, false)
+	if diags.HasError() {
+		return prior, diags
+	}
+
+	names := make([]string, len(assigned))
+	for i, role := range assigned {
+		names[i] = role.Name
+		for _, name := range priorNames {
+			if strings.EqualFold(name, role.Name) {
+				names[i] = name
+				break
+			}
+		}
+	}
+
+	return types.SetValueFrom(ctx, types.StringType, names)
+}
+
 func convertIntSliceToInt64Slice(intSlice []int) []int64 {
 	result := make([]int64, len(intSlice))
 	for i, v := range intSlice {
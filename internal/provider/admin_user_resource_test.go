@@ -38,6 +38,57 @@ func TestAccAdminUserResource(t *testing.T) {
 	})
 }
 
+func TestAccAdminUserResource_roleNames(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdminUserResourceRoleNamesConfig(`["editor"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("strapi_admin_user.test", "role_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr("strapi_admin_user.test", "role_names.*", "editor"),
+					resource.TestCheckResourceAttr("strapi_admin_user.test", "roles.#", "1"),
+				),
+			},
+			{
+				Config: testAccAdminUserResourceRoleNamesConfig(`["Editor", "Author"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("strapi_admin_user.test", "role_names.#", "2"),
+					resource.TestCheckResourceAttr("strapi_admin_user.test", "roles.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAdminUserResource_passwordRotation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdminUserResourcePasswordConfig("FirstPass123!", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("strapi_admin_user.test", "password"),
+					resource.TestCheckResourceAttr("strapi_admin_user.test", "password_wo_version", "1"),
+				),
+			},
+			{
+				Config:   testAccAdminUserResourcePasswordConfig("FirstPass123!", 1),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccAdminUserResourcePasswordConfig("SecondPass123!", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("strapi_admin_user.test", "password"),
+					resource.TestCheckResourceAttr("strapi_admin_user.test", "password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccAdminUserResourceConfig(email, firstname, lastname string) string {
 	return fmt.Sprintf(`
 resource "strapi_admin_user" "test" {
@@ -49,3 +100,28 @@ resource "strapi_admin_user" "test" {
 }
 `, email, firstname, lastname)
 }
+
+func testAccAdminUserResourceRoleNamesConfig(roleNames string) string {
+	return fmt.Sprintf(`
+resource "strapi_admin_user" "test" {
+  email      = "testadmin-roles@example.com"
+  firstname  = "Role"
+  lastname   = "Names"
+  password   = "TestPass123!"
+  role_names = %s
+}
+`, roleNames)
+}
+
+func testAccAdminUserResourcePasswordConfig(password string, version int) string {
+	return fmt.Sprintf(`
+resource "strapi_admin_user" "test" {
+  email               = "testadmin-password@example.com"
+  firstname           = "Password"
+  lastname            = "Rotation"
+  password            = %[1]q
+  password_wo_version = %[2]d
+  roles               = [1]
+}
+`, password, version)
+}
@@ -0,0 +1,308 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fbritoferreira/terraform-provider-strapi/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	apiTokenTypeReadOnly   = "read-only"
+	apiTokenTypeFullAccess = "full-access"
+	apiTokenTypeCustom     = "custom"
+)
+
+var _ resource.Resource = &APITokenResource{}
+var _ resource.ResourceWithImportState = &APITokenResource{}
+var _ resource.ResourceWithModifyPlan = &APITokenResource{}
+var _ resource.ResourceWithValidateConfig = &APITokenResource{}
+
+type APITokenResource struct {
+	client *client.StrapiClient
+}
+
+type APITokenResourceModel struct {
+	ID                types.String   `tfsdk:"id"`
+	Name              types.String   `tfsdk:"name"`
+	Description       types.String   `tfsdk:"description"`
+	Type              types.String   `tfsdk:"type"`
+	Lifespan          types.Int64    `tfsdk:"lifespan"`
+	Permissions       []types.String `tfsdk:"permissions"`
+	RegenerateTrigger types.String   `tfsdk:"regenerate_trigger"`
+	AccessKey         types.String   `tfsdk:"access_key"`
+	ExpiresAt         types.String   `tfsdk:"expires_at"`
+	Expired           types.Bool     `tfsdk:"expired"`
+	CreatedAt         types.String   `tfsdk:"created_at"`
+}
+
+func NewAPITokenResource() resource.Resource {
+	return &APITokenResource{}
+}
+
+func (r *APITokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_token"
+}
+
+func (r *APITokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Strapi API token. The access key is only returned by Strapi when the token is created or regenerated, " +
+			"so it is stored in the Terraform state as a sensitive value.",
+		Attributes: tokenLifecycleAttributes("API token", map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(apiTokenTypeReadOnly),
+				MarkdownDescription: "The type of the API token: 'read-only', 'full-access' or 'custom'. Defaults to 'read-only'.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(apiTokenTypeReadOnly, apiTokenTypeFullAccess, apiTokenTypeCustom),
+				},
+			},
+			"permissions": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The actions granted to a 'custom' API token (e.g., 'api::article.article.find').",
+			},
+			"access_key": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The access key of the API token, used as the bearer token of API requests.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		}),
+	}
+}
+
+func (r *APITokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.StrapiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.StrapiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *APITokenResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config APITokenResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Type.IsUnknown() || config.Type.ValueString() == apiTokenTypeCustom || config.Permissions == nil {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("permissions"),
+		"Invalid API Token Permissions",
+		"Permissions can only be set on API tokens of type 'custom'.",
+	)
+}
+
+// ModifyPlan regenerates the access key or replaces the token as decided by modifyTokenPlan.
+func (r *APITokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyTokenPlan(ctx, req, resp)
+}
+
+func (r *APITokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan APITokenResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := r.client.CreateAPIToken(ctx, expandAPIToken(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating API token",
+			fmt.Sprintf("Could not create API token: %s", err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.Itoa(token.ID))
+	plan.AccessKey = types.StringValue(token.AccessKey)
+	plan.ExpiresAt = types.StringValue(token.ExpiresAt)
+	plan.Expired = types.BoolValue(tokenExpired(token.ExpiresAt))
+	plan.CreatedAt = types.StringValue(token.CreatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Created API token with ID: %d", token.ID))
+}
+
+func (r *APITokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state APITokenResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing API token ID",
+			fmt.Sprintf("Could not parse API token ID '%s': %s", state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	token, err := r.client.GetAPIToken(ctx, id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("API token with ID %d not found, removing from state", id))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading API token",
+			fmt.Sprintf("Could not read API token: %s", err),
+		)
+		return
+	}
+
+	state.Name = types.StringValue(token.Name)
+	state.Description = optionalStringValue(token.Description, state.Description)
+	state.Type = types.StringValue(token.Type)
+	state.Lifespan = lifespanDays(token.Lifespan)
+	state.Permissions = optionalStringValues(token.Permissions, state.Permissions)
+	state.ExpiresAt = types.StringValue(token.ExpiresAt)
+	state.Expired = types.BoolValue(tokenExpired(token.ExpiresAt))
+	state.CreatedAt = types.StringValue(token.CreatedAt)
+
+	if token.AccessKey != "" {
+		state.AccessKey = types.StringValue(token.AccessKey)
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Read API token with ID: %d", token.ID))
+}
+
+func (r *APITokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state APITokenResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing API token ID",
+			fmt.Sprintf("Could not parse API token ID '%s': %s", state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	token, err := r.client.UpdateAPIToken(ctx, id, expandAPIToken(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating API token",
+			fmt.Sprintf("Could not update API token: %s", err),
+		)
+		return
+	}
+
+	if shouldRegenerate(plan.RegenerateTrigger, state.RegenerateTrigger) {
+		regenerated, err := r.client.RegenerateAPIToken(ctx, id)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error regenerating API token",
+				fmt.Sprintf("Could not regenerate API token: %s", err),
+			)
+			return
+		}
+
+		plan.AccessKey = types.StringValue(regenerated.AccessKey)
+		tflog.Info(ctx, fmt.Sprintf("Regenerated access key of API token with ID: %d", id))
+	}
+
+	plan.ExpiresAt = types.StringValue(token.ExpiresAt)
+	plan.Expired = types.BoolValue(tokenExpired(token.ExpiresAt))
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Updated API token with ID: %d", id))
+}
+
+func (r *APITokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state APITokenResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing API token ID",
+			fmt.Sprintf("Could not parse API token ID '%s': %s", state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	err = r.client.DeleteAPIToken(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting API token",
+			fmt.Sprintf("Could not delete API token: %s", err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Deleted API token with ID: %d", id))
+}
+
+func (r *APITokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func expandAPIToken(plan APITokenResourceModel) client.APIToken {
+	return client.APIToken{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		Type:        plan.Type.ValueString(),
+		Lifespan:    lifespanMilliseconds(plan.Lifespan),
+		Permissions: stringValues(plan.Permissions),
+	}
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAPITokenResource(t *testing.T) {
+	var accessKey string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPITokenResourceConfig("Terraform CI", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("strapi_api_token.test", "name", "Terraform CI"),
+					resource.TestCheckResourceAttr("strapi_api_token.test", "type", "custom"),
+					resource.TestCheckResourceAttr("strapi_api_token.test", "lifespan", "30"),
+					resource.TestCheckResourceAttr("strapi_api_token.test", "permissions.#", "1"),
+					resource.TestCheckResourceAttr("strapi_api_token.test", "expired", "false"),
+					resource.TestCheckResourceAttrSet("strapi_api_token.test", "access_key"),
+					resource.TestCheckResourceAttrSet("strapi_api_token.test", "expires_at"),
+					resource.TestCheckResourceAttrWith("strapi_api_token.test", "access_key", func(value string) error {
+						accessKey = value
+						return nil
+					}),
+				),
+			},
+			{
+				ResourceName:            "strapi_api_token.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"access_key", "regenerate_trigger"},
+			},
+			{
+				Config: testAccAPITokenResourceConfig("Terraform CI", "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("strapi_api_token.test", "access_key", func(value string) error {
+						if value == accessKey {
+							return fmt.Errorf("expected access key to be regenerated")
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccAPITokenResourceConfig(name, trigger string) string {
+	return fmt.Sprintf(`
+resource "strapi_api_token" "test" {
+  name               = %[1]q
+  description        = "Token used by the API token acceptance test"
+  type               = "custom"
+  lifespan           = 30
+  permissions        = ["plugin::users-permissions.user.me"]
+  regenerate_trigger = %[2]q
+}
+`, name, trigger)
+}
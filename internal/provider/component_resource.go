@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fbritoferreira/terraform-provider-strapi/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &ComponentResource{}
+var _ resource.ResourceWithImportState = &ComponentResource{}
+var _ resource.ResourceWithModifyPlan = &ComponentResource{}
+
+type ComponentResource struct {
+	client *client.StrapiClient
+}
+
+type ComponentResourceModel struct {
+	ID          types.String                    `tfsdk:"id"`
+	UID         types.String                    `tfsdk:"uid"`
+	Category    types.String                    `tfsdk:"category"`
+	DisplayName types.String                    `tfsdk:"display_name"`
+	Description types.String                    `tfsdk:"description"`
+	Icon        types.String                    `tfsdk:"icon"`
+	Attributes  map[string]SchemaAttributeModel `tfsdk:"attributes"`
+}
+
+func NewComponentResource() resource.Resource {
+	return &ComponentResource{}
+}
+
+func (r *ComponentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_component"
+}
+
+func (r *ComponentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a reusable Strapi component through the Content-Type Builder API. " +
+			"Components can be nested by declaring attributes of type 'component' that reference other components.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The UID of the component.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"uid": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The UID of the component (e.g., 'shared.seo').",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"category": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The category the component belongs to (e.g., 'shared').",
+			},
+			"display_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name displayed in the admin panel. Strapi derives the component UID from the category and this name.",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The description of the component.",
+			},
+			"icon": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The icon displayed for the component in the admin panel.",
+			},
+			"attributes": schemaAttributesSchema(),
+		},
+	}
+}
+
+func (r *ComponentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.StrapiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.StrapiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan marks the UID as unknown when the category or display name changes, since Strapi derives the UID from them.
+func (r *ComponentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state ComponentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Category.Equal(state.Category) && plan.DisplayName.Equal(state.DisplayName) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("uid"), types.StringUnknown())...)
+}
+
+func (r *ComponentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ComponentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	componentSchema, err := expandComponentSchema(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error building component schema",
+			fmt.Sprintf("Could not build component schema: %s", err),
+		)
+		return
+	}
+
+	uid, err := r.client.CreateComponent(ctx, plan.Category.ValueString(), componentSchema)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating component",
+			fmt.Sprintf("Could not create component: %s", err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(uid)
+	plan.UID = types.StringValue(uid)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Created component with UID: %s", uid))
+}
+
+func (r *ComponentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ComponentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	component, err := r.client.GetComponent(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Component with UID %s not found, removing from state", state.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading component",
+			fmt.Sprintf("Could not read component: %s", err),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(component.UID)
+	state.UID = types.StringValue(component.UID)
+	state.Category = types.StringValue(component.Category)
+	state.DisplayName = types.StringValue(component.Schema.DisplayName)
+	state.Description = optionalStringValue(component.Schema.Description, state.Description)
+	state.Icon = optionalStringValue(component.Schema.Icon, state.Icon)
+	state.Attributes = flattenSchemaAttributes(component.Schema.Attributes, state.Attributes)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Read component with UID: %s", component.UID))
+}
+
+func (r *ComponentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ComponentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	componentSchema, err := expandComponentSchema(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error building component schema",
+			fmt.Sprintf("Could not build component schema: %s", err),
+		)
+		return
+	}
+
+	uid, err := r.client.UpdateComponent(ctx, state.ID.ValueString(), plan.Category.ValueString(), componentSchema)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating component",
+			fmt.Sprintf("Could not update component: %s", err),
+		)
+		return
+	}
+
+	if uid == "" {
+		uid = state.ID.ValueString()
+	}
+
+	plan.ID = types.StringValue(uid)
+	plan.UID = types.StringValue(uid)
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Updated component with UID: %s", uid))
+}
+
+func (r *ComponentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ComponentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteComponent(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting component",
+			fmt.Sprintf("Could not delete component: %s", err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Deleted component with UID: %s", state.ID.ValueString()))
+}
+
+func (r *ComponentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func expandComponentSchema(plan ComponentResourceModel) (client.ComponentSchema, error) {
+	attributes, err := expandSchemaAttributes(plan.Attributes)
+	if err != nil {
+		return client.ComponentSchema{}, err
+	}
+
+	return client.ComponentSchema{
+		DisplayName: plan.DisplayName.ValueString(),
+		Description: plan.Description.ValueString(),
+		Icon:        plan.Icon.ValueString(),
+		Attributes:  attributes,
+	}, nil
+}
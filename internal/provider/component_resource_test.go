@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccComponentResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentResourceConfig("Meta title"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("strapi_component.test", "uid", "tfacc.seo"),
+					resource.TestCheckResourceAttr("strapi_component.test", "category", "tfacc"),
+					resource.TestCheckResourceAttr("strapi_component.test", "attributes.meta_title.type", "string"),
+				),
+			},
+			{
+				ResourceName:      "strapi_component.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComponentResourceConfig("Updated meta title"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("strapi_component.test", "description", "Updated meta title"),
+				),
+			},
+		},
+	})
+}
+
+func testAccComponentResourceConfig(description string) string {
+	return fmt.Sprintf(`
+resource "strapi_component" "test" {
+  category     = "tfacc"
+  display_name = "seo"
+  description  = %[1]q
+
+  attributes = {
+    meta_title = {
+      type       = "string"
+      required   = true
+      max_length = 60
+    }
+    meta_description = {
+      type = "text"
+    }
+  }
+}
+`, description)
+}
@@ -0,0 +1,628 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/fbritoferreira/terraform-provider-strapi/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &ContentTypeResource{}
+var _ resource.ResourceWithImportState = &ContentTypeResource{}
+
+// systemAttributes are added to every content type by Strapi and are never managed by Terraform.
+var systemAttributes = map[string]bool{
+	"id":            true,
+	"documentId":    true,
+	"createdAt":     true,
+	"updatedAt":     true,
+	"publishedAt":   true,
+	"createdBy":     true,
+	"updatedBy":     true,
+	"locale":        true,
+	"localizations": true,
+}
+
+type ContentTypeResource struct {
+	client *client.StrapiClient
+}
+
+type ContentTypeResourceModel struct {
+	ID              types.String                    `tfsdk:"id"`
+	UID             types.String                    `tfsdk:"uid"`
+	Kind            types.String                    `tfsdk:"kind"`
+	SingularName    types.String                    `tfsdk:"singular_name"`
+	PluralName      types.String                    `tfsdk:"plural_name"`
+	DisplayName     types.String                    `tfsdk:"display_name"`
+	Description     types.String                    `tfsdk:"description"`
+	DraftAndPublish types.Bool                      `tfsdk:"draft_and_publish"`
+	I18n            types.Bool                      `tfsdk:"i18n"`
+	Attributes      map[string]SchemaAttributeModel `tfsdk:"attributes"`
+}
+
+// SchemaAttributeModel describes a single attribute of a content type or component.
+type SchemaAttributeModel struct {
+	Type            types.String   `tfsdk:"type"`
+	Required        types.Bool     `tfsdk:"required"`
+	Unique          types.Bool     `tfsdk:"unique"`
+	Private         types.Bool     `tfsdk:"private"`
+	Localized       types.Bool     `tfsdk:"localized"`
+	Default         types.String   `tfsdk:"default"`
+	Enum            []types.String `tfsdk:"enum"`
+	MinLength       types.Int64    `tfsdk:"min_length"`
+	MaxLength       types.Int64    `tfsdk:"max_length"`
+	Relation        types.String   `tfsdk:"relation"`
+	Target          types.String   `tfsdk:"target"`
+	TargetAttribute types.String   `tfsdk:"target_attribute"`
+	Component       types.String   `tfsdk:"component"`
+	Repeatable      types.Bool     `tfsdk:"repeatable"`
+	Components      []types.String `tfsdk:"components"`
+	Multiple        types.Bool     `tfsdk:"multiple"`
+	AllowedTypes    []types.String `tfsdk:"allowed_types"`
+}
+
+func NewContentTypeResource() resource.Resource {
+	return &ContentTypeResource{}
+}
+
+func (r *ContentTypeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_content_type"
+}
+
+func (r *ContentTypeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Strapi content type (collection or single type) through the Content-Type Builder API. " +
+			"Strapi restarts itself after every schema change; the provider waits for it to come back before continuing. " +
+			"The Content-Type Builder is only available when Strapi runs in development mode.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The UID of the content type.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"uid": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The UID of the content type (e.g., 'api::article.article').",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"kind": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The kind of content type. One of 'collectionType' or 'singleType'.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("collectionType", "singleType"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"singular_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The singular API ID of the content type. Changing this forces a new content type.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"plural_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The plural API ID of the content type.",
+			},
+			"display_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name displayed in the admin panel.",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The description of the content type.",
+			},
+			"draft_and_publish": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the draft and publish feature is enabled. Defaults to `false`.",
+			},
+			"i18n": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the content type is localized with the i18n plugin. Defaults to `false`.",
+			},
+			"attributes": schemaAttributesSchema(),
+		},
+	}
+}
+
+// schemaAttributesSchema returns the schema of the attributes map shared by content types and components.
+func schemaAttributesSchema() schema.MapNestedAttribute {
+	return schema.MapNestedAttribute{
+		Required:            true,
+		MarkdownDescription: "The attributes of the schema, keyed by attribute name.",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Required:            true,
+					MarkdownDescription: "The type of the attribute (e.g., 'string', 'text', 'integer', 'boolean', 'relation', 'component', 'media').",
+					Validators: []validator.String{
+						stringvalidator.OneOf(
+							"string", "text", "richtext", "blocks", "email", "password", "uid",
+							"integer", "biginteger", "float", "decimal", "boolean",
+							"date", "time", "datetime", "timestamp",
+							"enumeration", "json", "media", "relation", "component", "dynamiczone",
+						),
+					},
+				},
+				"required": schema.BoolAttribute{
+					Optional:            true,
+					MarkdownDescription: "Whether the attribute is required.",
+				},
+				"unique": schema.BoolAttribute{
+					Optional:            true,
+					MarkdownDescription: "Whether the attribute value must be unique.",
+				},
+				"private": schema.BoolAttribute{
+					Optional:            true,
+					MarkdownDescription: "Whether the attribute is hidden from API responses.",
+				},
+				"localized": schema.BoolAttribute{
+					Optional:            true,
+					MarkdownDescription: "Whether the attribute is localized. Only relevant when i18n is enabled.",
+				},
+				"default": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "The default value of the attribute. Converted according to the attribute type; JSON attributes expect a JSON-encoded value.",
+				},
+				"enum": schema.ListAttribute{
+					Optional:            true,
+					ElementType:         types.StringType,
+					MarkdownDescription: "The allowed values of an 'enumeration' attribute.",
+				},
+				"min_length": schema.Int64Attribute{
+					Optional:            true,
+					MarkdownDescription: "The minimum length of a text attribute.",
+				},
+				"max_length": schema.Int64Attribute{
+					Optional:            true,
+					MarkdownDescription: "The maximum length of a text attribute.",
+				},
+				"relation": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "The relation type of a 'relation' attribute (e.g., 'oneToOne', 'oneToMany', 'manyToOne', 'manyToMany').",
+				},
+				"target": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "The UID of the target content type of a 'relation' attribute.",
+				},
+				"target_attribute": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "The name of the inverse attribute on the target content type of a bidirectional relation.",
+				},
+				"component": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "The UID of the component used by a 'component' attribute (e.g., 'shared.seo').",
+				},
+				"repeatable": schema.BoolAttribute{
+					Optional:            true,
+					MarkdownDescription: "Whether a 'component' attribute is repeatable.",
+				},
+				"components": schema.ListAttribute{
+					Optional:            true,
+					ElementType:         types.StringType,
+					MarkdownDescription: "The UIDs of the components allowed in a 'dynamiczone' attribute.",
+				},
+				"multiple": schema.BoolAttribute{
+					Optional:            true,
+					MarkdownDescription: "Whether a 'media' attribute accepts multiple files.",
+				},
+				"allowed_types": schema.ListAttribute{
+					Optional:            true,
+					ElementType:         types.StringType,
+					MarkdownDescription: "The file types accepted by a 'media' attribute (e.g., 'images', 'files', 'videos', 'audios').",
+				},
+			},
+		},
+	}
+}
+
+func (r *ContentTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.StrapiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.StrapiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ContentTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ContentTypeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	contentTypeSchema, err := expandContentTypeSchema(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error building content type schema",
+			fmt.Sprintf("Could not build content type schema: %s", err),
+		)
+		return
+	}
+
+	uid, err := r.client.CreateContentType(ctx, contentTypeSchema)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating content type",
+			fmt.Sprintf("Could not create content type: %s", err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(uid)
+	plan.UID = types.StringValue(uid)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Created content type with UID: %s", uid))
+}
+
+func (r *ContentTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ContentTypeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	contentType, err := r.client.GetContentType(ctx, state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Content type with UID %s not found, removing from state", state.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading content type",
+			fmt.Sprintf("Could not read content type: %s", err),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(contentType.UID)
+	state.UID = types.StringValue(contentType.UID)
+	state.Kind = types.StringValue(contentType.Schema.Kind)
+	state.SingularName = types.StringValue(contentType.Schema.SingularName)
+	state.PluralName = types.StringValue(contentType.Schema.PluralName)
+	state.DisplayName = types.StringValue(contentType.Schema.DisplayName)
+	state.Description = optionalStringValue(contentType.Schema.Description, state.Description)
+	state.DraftAndPublish = types.BoolValue(contentType.Schema.DraftAndPublish)
+	state.I18n = types.BoolValue(isLocalized(contentType.Schema.PluginOptions))
+	state.Attributes = flattenSchemaAttributes(contentType.Schema.Attributes, state.Attributes)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Read content type with UID: %s", contentType.UID))
+}
+
+func (r *ContentTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ContentTypeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	contentTypeSchema, err := expandContentTypeSchema(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error building content type schema",
+			fmt.Sprintf("Could not build content type schema: %s", err),
+		)
+		return
+	}
+
+	uid, err := r.client.UpdateContentType(ctx, plan.ID.ValueString(), contentTypeSchema)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating content type",
+			fmt.Sprintf("Could not update content type: %s", err),
+		)
+		return
+	}
+
+	if uid != "" {
+		plan.ID = types.StringValue(uid)
+		plan.UID = types.StringValue(uid)
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Updated content type with UID: %s", plan.ID.ValueString()))
+}
+
+func (r *ContentTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ContentTypeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteContentType(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting content type",
+			fmt.Sprintf("Could not delete content type: %s", err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Deleted content type with UID: %s", state.ID.ValueString()))
+}
+
+func (r *ContentTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func expandContentTypeSchema(plan ContentTypeResourceModel) (client.ContentTypeSchema, error) {
+	attributes, err := expandSchemaAttributes(plan.Attributes)
+	if err != nil {
+		return client.ContentTypeSchema{}, err
+	}
+
+	contentTypeSchema := client.ContentTypeSchema{
+		Kind:            plan.Kind.ValueString(),
+		SingularName:    plan.SingularName.ValueString(),
+		PluralName:      plan.PluralName.ValueString(),
+		DisplayName:     plan.DisplayName.ValueString(),
+		Description:     plan.Description.ValueString(),
+		DraftAndPublish: plan.DraftAndPublish.ValueBool(),
+		Attributes:      attributes,
+	}
+
+	if plan.I18n.ValueBool() {
+		contentTypeSchema.PluginOptions = localizedPluginOptions(true)
+	}
+
+	return contentTypeSchema, nil
+}
+
+func expandSchemaAttributes(models map[string]SchemaAttributeModel) (map[string]client.ContentTypeAttribute, error) {
+	attributes := make(map[string]client.ContentTypeAttribute, len(models))
+
+	for name, model := range models {
+		attribute := client.ContentTypeAttribute{
+			Type:            model.Type.ValueString(),
+			Required:        model.Required.ValueBoolPointer(),
+			Unique:          model.Unique.ValueBoolPointer(),
+			Private:         model.Private.ValueBoolPointer(),
+			Enum:            stringValues(model.Enum),
+			Relation:        model.Relation.ValueString(),
+			Target:          model.Target.ValueString(),
+			TargetAttribute: model.TargetAttribute.ValueString(),
+			Component:       model.Component.ValueString(),
+			Repeatable:      model.Repeatable.ValueBoolPointer(),
+			Components:      stringValues(model.Components),
+			Multiple:        model.Multiple.ValueBoolPointer(),
+			AllowedTypes:    stringValues(model.AllowedTypes),
+		}
+
+		if !model.MinLength.IsNull() {
+			minLength := int(model.MinLength.ValueInt64())
+			attribute.MinLength = &minLength
+		}
+
+		if !model.MaxLength.IsNull() {
+			maxLength := int(model.MaxLength.ValueInt64())
+			attribute.MaxLength = &maxLength
+		}
+
+		if !model.Localized.IsNull() {
+			attribute.PluginOptions = localizedPluginOptions(model.Localized.ValueBool())
+		}
+
+		if !model.Default.IsNull() {
+			defaultValue, err := parseAttributeDefault(attribute.Type, model.Default.ValueString())
+			if err != nil {
+				return nil, fmt.Errorf("invalid default for attribute '%s': %w", name, err)
+			}
+			attribute.Default = defaultValue
+		}
+
+		attributes[name] = attribute
+	}
+
+	return attributes, nil
+}
+
+// flattenSchemaAttributes converts the attributes returned by Strapi into their Terraform representation.
+// Values Strapi reports as false or empty are kept null when they are null in the prior state, so that
+// omitting an optional setting in the configuration does not produce a diff.
+func flattenSchemaAttributes(attributes map[string]client.ContentTypeAttribute, prior map[string]SchemaAttributeModel) map[string]SchemaAttributeModel {
+	models := make(map[string]SchemaAttributeModel, len(attributes))
+
+	for name, attribute := range attributes {
+		if systemAttributes[name] {
+			continue
+		}
+
+		previous := prior[name]
+
+		model := SchemaAttributeModel{
+			Type:            types.StringValue(attribute.Type),
+			Required:        optionalBoolValue(attribute.Required, previous.Required),
+			Unique:          optionalBoolValue(attribute.Unique, previous.Unique),
+			Private:         optionalBoolValue(attribute.Private, previous.Private),
+			Localized:       types.BoolNull(),
+			Default:         types.StringNull(),
+			Enum:            optionalStringValues(attribute.Enum, previous.Enum),
+			MinLength:       types.Int64Null(),
+			MaxLength:       types.Int64Null(),
+			Relation:        optionalStringValue(attribute.Relation, previous.Relation),
+			Target:          optionalStringValue(attribute.Target, previous.Target),
+			TargetAttribute: optionalStringValue(attribute.TargetAttribute, previous.TargetAttribute),
+			Component:       optionalStringValue(attribute.Component, previous.Component),
+			Repeatable:      optionalBoolValue(attribute.Repeatable, previous.Repeatable),
+			Components:      optionalStringValues(attribute.Components, previous.Components),
+			Multiple:        optionalBoolValue(attribute.Multiple, previous.Multiple),
+			AllowedTypes:    optionalStringValues(attribute.AllowedTypes, previous.AllowedTypes),
+		}
+
+		if attribute.MinLength != nil {
+			model.MinLength = types.Int64Value(int64(*attribute.MinLength))
+		}
+
+		if attribute.MaxLength != nil {
+			model.MaxLength = types.Int64Value(int64(*attribute.MaxLength))
+		}
+
+		if localized, ok := localizedOption(attribute.PluginOptions); ok {
+			localizedValue := localized
+			model.Localized = optionalBoolValue(&localizedValue, previous.Localized)
+		}
+
+		if attribute.Default != nil {
+			model.Default = types.StringValue(formatAttributeDefault(attribute.Default))
+		}
+
+		models[name] = model
+	}
+
+	return models
+}
+
+// parseAttributeDefault converts a default value from its string representation to the type Strapi expects.
+func parseAttributeDefault(attributeType, value string) (interface{}, error) {
+	switch attributeType {
+	case "boolean":
+		return strconv.ParseBool(value)
+	case "integer":
+		return strconv.ParseInt(value, 10, 64)
+	case "float", "decimal":
+		return strconv.ParseFloat(value, 64)
+	case "json":
+		var decoded interface{}
+		if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+			return nil, err
+		}
+		return decoded, nil
+	default:
+		return value, nil
+	}
+}
+
+// formatAttributeDefault converts a default value returned by Strapi to its string representation.
+func formatAttributeDefault(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+		return string(encoded)
+	}
+}
+
+func localizedPluginOptions(localized bool) map[string]interface{} {
+	return map[string]interface{}{
+		"i18n": map[string]interface{}{
+			"localized": localized,
+		},
+	}
+}
+
+// localizedOption extracts the i18n localized flag from a set of plugin options.
+func localizedOption(pluginOptions map[string]interface{}) (bool, bool) {
+	i18n, ok := pluginOptions["i18n"].(map[string]interface{})
+	if !ok {
+		return false, false
+	}
+
+	localized, ok := i18n["localized"].(bool)
+	return localized, ok
+}
+
+func isLocalized(pluginOptions map[string]interface{}) bool {
+	localized, _ := localizedOption(pluginOptions)
+	return localized
+}
+
+func stringValues(values []types.String) []string {
+	if values == nil {
+		return nil
+	}
+
+	result := make([]string, len(values))
+	for i, v := range values {
+		result[i] = v.ValueString()
+	}
+	return result
+}
+
+func optionalBoolValue(value *bool, prior types.Bool) types.Bool {
+	if value == nil || (!*value && prior.IsNull()) {
+		return types.BoolNull()
+	}
+	return types.BoolValue(*value)
+}
+
+func optionalStringValue(value string, prior types.String) types.String {
+	if value == "" && prior.IsNull() {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+func optionalStringValues(values []string, prior []types.String) []types.String {
+	if len(values) == 0 && prior == nil {
+		return nil
+	}
+
+	result := make([]types.String, len(values))
+	for i, v := range values {
+		result[i] = types.StringValue(v)
+	}
+	return result
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccContentTypeResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentTypeResourceConfig("Test Article"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("strapi_content_type.test", "uid", "api::tfacc-article.tfacc-article"),
+					resource.TestCheckResourceAttr("strapi_content_type.test", "display_name", "Test Article"),
+					resource.TestCheckResourceAttr("strapi_content_type.test", "attributes.title.type", "string"),
+					resource.TestCheckResourceAttr("strapi_content_type.test", "attributes.title.required", "true"),
+				),
+			},
+			{
+				ResourceName:      "strapi_content_type.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccContentTypeResourceConfig("Updated Article"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("strapi_content_type.test", "display_name", "Updated Article"),
+				),
+			},
+		},
+	})
+}
+
+func testAccContentTypeResourceConfig(displayName string) string {
+	return fmt.Sprintf(`
+resource "strapi_content_type" "test" {
+  kind          = "collectionType"
+  singular_name = "tfacc-article"
+  plural_name   = "tfacc-articles"
+  display_name  = %[1]q
+
+  attributes = {
+    title = {
+      type     = "string"
+      required = true
+    }
+    views = {
+      type    = "integer"
+      default = "0"
+    }
+  }
+}
+`, displayName)
+}
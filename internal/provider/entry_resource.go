@@ -0,0 +1,293 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/fbritoferreira/terraform-provider-strapi/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &EntryResource{}
+var _ resource.ResourceWithImportState = &EntryResource{}
+
+type EntryResource struct {
+	client *client.StrapiClient
+}
+
+type EntryResourceModel struct {
+	ID          types.String         `tfsdk:"id"`
+	ContentType types.String         `tfsdk:"content_type"`
+	DocumentID  types.String         `tfsdk:"document_id"`
+	Data        jsontypes.Normalized `tfsdk:"data"`
+}
+
+func NewEntryResource() resource.Resource {
+	return &EntryResource{}
+}
+
+func (r *EntryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entry"
+}
+
+func (r *EntryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a document of a Strapi collection type through the content API.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the entry in the form `<content_type>/<document_id>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"content_type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The plural API ID of the collection type (e.g., 'articles'). Changing this forces a new entry.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"document_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The document ID of the entry.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"data": schema.StringAttribute{
+				Required:            true,
+				CustomType:          jsontypes.NormalizedType{},
+				MarkdownDescription: "The JSON-encoded fields of the entry. Only the fields present here are managed and checked for drift.",
+			},
+		},
+	}
+}
+
+func (r *EntryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.StrapiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.StrapiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *EntryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan EntryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := expandEntryData(plan.Data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing entry data",
+			fmt.Sprintf("Could not parse entry data: %s", err),
+		)
+		return
+	}
+
+	entry, err := r.client.CreateEntry(ctx, plan.ContentType.ValueString(), data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating entry",
+			fmt.Sprintf("Could not create entry: %s", err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(entryID(plan.ContentType.ValueString(), entry.DocumentID))
+	plan.DocumentID = types.StringValue(entry.DocumentID)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Created entry with document ID: %s", entry.DocumentID))
+}
+
+func (r *EntryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state EntryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entry, err := r.client.GetEntry(ctx, state.ContentType.ValueString(), state.DocumentID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Entry with document ID %s not found, removing from state", state.DocumentID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading entry",
+			fmt.Sprintf("Could not read entry: %s", err),
+		)
+		return
+	}
+
+	data, err := flattenEntryData(entry.Data, state.Data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error encoding entry data",
+			fmt.Sprintf("Could not encode entry data: %s", err),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(entryID(state.ContentType.ValueString(), entry.DocumentID))
+	state.DocumentID = types.StringValue(entry.DocumentID)
+	state.Data = data
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Read entry with document ID: %s", entry.DocumentID))
+}
+
+func (r *EntryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan EntryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := expandEntryData(plan.Data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing entry data",
+			fmt.Sprintf("Could not parse entry data: %s", err),
+		)
+		return
+	}
+
+	entry, err := r.client.UpdateEntry(ctx, plan.ContentType.ValueString(), plan.DocumentID.ValueString(), data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating entry",
+			fmt.Sprintf("Could not update entry: %s", err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Updated entry with document ID: %s", entry.DocumentID))
+}
+
+func (r *EntryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state EntryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteEntry(ctx, state.ContentType.ValueString(), state.DocumentID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting entry",
+			fmt.Sprintf("Could not delete entry: %s", err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Deleted entry with document ID: %s", state.DocumentID.ValueString()))
+}
+
+func (r *EntryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <content_type>/<document_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("content_type"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("document_id"), parts[1])...)
+}
+
+func entryID(contentType, documentID string) string {
+	return contentType + "/" + documentID
+}
+
+// expandEntryData decodes the JSON-encoded data of an entry.
+func expandEntryData(data jsontypes.Normalized) (map[string]interface{}, error) {
+	var decoded map[string]interface{}
+	if err := json.Unmarshal([]byte(data.ValueString()), &decoded); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+// flattenEntryData encodes the fields returned by Strapi as JSON. Only the keys present in the prior data are
+// kept so that fields populated by Strapi do not show up as drift; fields Strapi does not return by default,
+// such as relations and media, keep their prior value. When there is no prior data, as after an import,
+// every field except the ones managed by Strapi itself is kept.
+func flattenEntryData(fields map[string]interface{}, prior jsontypes.Normalized) (jsontypes.Normalized, error) {
+	result := make(map[string]interface{})
+
+	if prior.IsNull() || prior.IsUnknown() || prior.ValueString() == "" {
+		for key, value := range fields {
+			if !systemAttributes[key] {
+				result[key] = value
+			}
+		}
+	} else {
+		priorFields, err := expandEntryData(prior)
+		if err != nil {
+			return jsontypes.NewNormalizedNull(), err
+		}
+
+		for key, priorValue := range priorFields {
+			if value, ok := fields[key]; ok {
+				result[key] = value
+			} else {
+				result[key] = priorValue
+			}
+		}
+	}
+
+	encoded, err := json.Marshal(result)
+	if err != nil {
+		return jsontypes.NewNormalizedNull(), err
+	}
+
+	return jsontypes.NewNormalizedValue(string(encoded)), nil
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEntryResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntryResourceConfig("Hello"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("strapi_entry.test", "content_type", "tfacc-notes"),
+					resource.TestCheckResourceAttrSet("strapi_entry.test", "document_id"),
+				),
+			},
+			{
+				ResourceName:            "strapi_entry.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"data"},
+			},
+			{
+				Config: testAccEntryResourceConfig("Updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("strapi_entry.test", "data", `{"title":"Updated"}`),
+				),
+			},
+		},
+	})
+}
+
+func testAccEntryResourceConfig(title string) string {
+	return fmt.Sprintf(`
+resource "strapi_content_type" "test" {
+  kind          = "collectionType"
+  singular_name = "tfacc-note"
+  plural_name   = "tfacc-notes"
+  display_name  = "Test Note"
+
+  attributes = {
+    title = {
+      type = "string"
+    }
+  }
+}
+
+resource "strapi_entry" "test" {
+  content_type = strapi_content_type.test.plural_name
+  data = jsonencode({
+    title = %[1]q
+  })
+}
+`, title)
+}
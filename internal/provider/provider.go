@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/fbritoferreira/terraform-provider-strapi/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
@@ -14,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -28,8 +32,15 @@ type StrapiProvider struct {
 }
 
 type StrapiProviderModel struct {
-	Endpoint types.String `tfsdk:"endpoint"`
-	APIToken types.String `tfsdk:"api_token"`
+	Endpoint              types.String  `tfsdk:"endpoint"`
+	APIToken              types.String  `tfsdk:"api_token"`
+	AdminEmail            types.String  `tfsdk:"admin_email"`
+	AdminPassword         types.String  `tfsdk:"admin_password"`
+	RequestTimeout        types.Int64   `tfsdk:"request_timeout"`
+	MaxRetries            types.Int64   `tfsdk:"max_retries"`
+	RetryMaxWait          types.Int64   `tfsdk:"retry_max_wait"`
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
 }
 
 func (p *StrapiProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -41,7 +52,8 @@ func (p *StrapiProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 	resp.Schema = schema.Schema{
 		MarkdownDescription: `The Strapi provider allows you to manage Strapi CMS resources.
 		
-Configure the provider with the endpoint and API token for your Strapi instance.`,
+Configure the provider with the endpoint and API token for your Strapi instance. Admin panel resources
+can authenticate with the credentials of an admin user instead.`,
 		Attributes: map[string]schema.Attribute{
 			"endpoint": schema.StringAttribute{
 				MarkdownDescription: "The Strapi API endpoint URL. Can also be provided via STRAPI_ENDPOINT environment variable.",
@@ -52,6 +64,50 @@ Configure the provider with the endpoint and API token for your Strapi instance.
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"admin_email": schema.StringAttribute{
+				MarkdownDescription: "The email of a Strapi admin user. When set together with `admin_password`, admin panel endpoints are called with an admin JWT obtained from `/admin/login` instead of the API token. Can also be provided via STRAPI_ADMIN_EMAIL environment variable.",
+				Optional:            true,
+			},
+			"admin_password": schema.StringAttribute{
+				MarkdownDescription: "The password of the Strapi admin user. Can also be provided via STRAPI_ADMIN_PASSWORD environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"request_timeout": schema.Int64Attribute{
+				MarkdownDescription: "The maximum duration of a single request to Strapi, in seconds. Defaults to 60. Set to 0 to disable the timeout.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "The number of times a request failing with a transient error (connection error, 429 or 5xx) is retried. Defaults to 3. Set to 0 to disable retries.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				MarkdownDescription: "The longest wait between two attempts of a request, in seconds. Defaults to 30.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "The maximum number of requests per second sent to Strapi, shared by all resources and data sources. Unlimited by default.",
+				Optional:            true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of requests in flight to Strapi at the same time, shared by all resources and data sources. Unlimited by default.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 		},
 	}
 }
@@ -69,6 +125,8 @@ func (p *StrapiProvider) Configure(ctx context.Context, req provider.ConfigureRe
 
 	endpoint := os.Getenv("STRAPI_ENDPOINT")
 	apiToken := os.Getenv("STRAPI_API_TOKEN")
+	adminEmail := os.Getenv("STRAPI_ADMIN_EMAIL")
+	adminPassword := os.Getenv("STRAPI_ADMIN_PASSWORD")
 
 	if !config.Endpoint.IsNull() {
 		endpoint = config.Endpoint.ValueString()
@@ -78,6 +136,14 @@ func (p *StrapiProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		apiToken = config.APIToken.ValueString()
 	}
 
+	if !config.AdminEmail.IsNull() {
+		adminEmail = config.AdminEmail.ValueString()
+	}
+
+	if !config.AdminPassword.IsNull() {
+		adminPassword = config.AdminPassword.ValueString()
+	}
+
 	if endpoint == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("endpoint"),
@@ -87,12 +153,31 @@ func (p *StrapiProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		)
 	}
 
-	if apiToken == "" {
+	if adminEmail != "" && adminPassword == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("admin_password"),
+			"Missing Strapi Admin Password",
+			"The provider cannot log in to the Strapi admin panel as admin_email is set without a password. "+
+				"Set the admin_password value in the configuration or use the STRAPI_ADMIN_PASSWORD environment variable.",
+		)
+	}
+
+	if adminPassword != "" && adminEmail == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("admin_email"),
+			"Missing Strapi Admin Email",
+			"The provider cannot log in to the Strapi admin panel as admin_password is set without an email. "+
+				"Set the admin_email value in the configuration or use the STRAPI_ADMIN_EMAIL environment variable.",
+		)
+	}
+
+	if apiToken == "" && adminEmail == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("api_token"),
 			"Missing Strapi API Token",
 			"The provider cannot create the Strapi client as there is a missing or empty value for the Strapi API token. "+
-				"Set the api_token value in the configuration or use the STRAPI_API_TOKEN environment variable.",
+				"Set the api_token value in the configuration or use the STRAPI_API_TOKEN environment variable, "+
+				"or set admin_email and admin_password to manage admin panel resources only.",
 		)
 	}
 
@@ -101,6 +186,29 @@ func (p *StrapiProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	}
 
 	strapiClient := client.New(endpoint, apiToken)
+	strapiClient.AdminEmail = adminEmail
+	strapiClient.AdminPassword = adminPassword
+
+	if !config.RequestTimeout.IsNull() {
+		strapiClient.RequestTimeout = time.Duration(config.RequestTimeout.ValueInt64()) * time.Second
+	}
+
+	if !config.MaxRetries.IsNull() {
+		strapiClient.MaxRetries = int(config.MaxRetries.ValueInt64())
+	}
+
+	if !config.RetryMaxWait.IsNull() {
+		strapiClient.RetryMaxWait = time.Duration(config.RetryMaxWait.ValueInt64()) * time.Second
+	}
+
+	if !config.RequestsPerSecond.IsNull() {
+		strapiClient.SetRateLimit(config.RequestsPerSecond.ValueFloat64())
+	}
+
+	if !config.MaxConcurrentRequests.IsNull() {
+		strapiClient.SetMaxConcurrentRequests(int(config.MaxConcurrentRequests.ValueInt64()))
+	}
+
 	resp.DataSourceData = strapiClient
 	resp.ResourceData = strapiClient
 
@@ -112,6 +220,16 @@ func (p *StrapiProvider) Resources(ctx context.Context) []func() resource.Resour
 		NewUserResource,
 		NewRoleResource,
 		NewAdminUserResource,
+		NewContentTypeResource,
+		NewComponentResource,
+		NewEntryResource,
+		NewSingleTypeEntryResource,
+		NewRolePermissionResource,
+		NewAdminRoleResource,
+		NewAdminRolePermissionsResource,
+		NewAPITokenResource,
+		NewTransferTokenResource,
+		NewWebhookResource,
 	}
 }
 
@@ -122,6 +240,11 @@ func (p *StrapiProvider) EphemeralResources(ctx context.Context) []func() epheme
 func (p *StrapiProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewRolesDataSource,
+		NewRoleDataSource,
+		NewUserDataSource,
+		NewUsersDataSource,
+		NewAdminUserDataSource,
+		NewAdminRolesDataSource,
 	}
 }
 
@@ -16,7 +16,7 @@ func testAccPreCheck(t *testing.T) {
 	if v := os.Getenv("STRAPI_ENDPOINT"); v == "" {
 		t.Fatal("STRAPI_ENDPOINT must be set for acceptance tests")
 	}
-	if v := os.Getenv("STRAPI_API_TOKEN"); v == "" {
-		t.Fatal("STRAPI_API_TOKEN must be set for acceptance tests")
+	if os.Getenv("STRAPI_API_TOKEN") == "" && os.Getenv("STRAPI_ADMIN_EMAIL") == "" {
+		t.Fatal("STRAPI_API_TOKEN or STRAPI_ADMIN_EMAIL and STRAPI_ADMIN_PASSWORD must be set for acceptance tests")
 	}
 }
@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fbritoferreira/terraform-provider-strapi/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &RoleDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &RoleDataSource{}
+
+type RoleDataSource struct {
+	client *client.StrapiClient
+}
+
+type RoleDataSourceModel struct {
+	ID          types.String                   `tfsdk:"id"`
+	Name        types.String                   `tfsdk:"name"`
+	Type        types.String                   `tfsdk:"type"`
+	Description types.String                   `tfsdk:"description"`
+	UserCount   types.Int64                    `tfsdk:"user_count"`
+	Permissions map[string]RolePermissionModel `tfsdk:"permissions"`
+	CreatedAt   types.String                   `tfsdk:"created_at"`
+	UpdatedAt   types.String                   `tfsdk:"updated_at"`
+}
+
+func NewRoleDataSource() datasource.DataSource {
+	return &RoleDataSource{}
+}
+
+func (d *RoleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+func (d *RoleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a single Strapi users-permissions role by ID, name or type.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the role.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the role. The lookup is case-insensitive.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the role (e.g., 'authenticated', 'public').",
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the role.",
+				Computed:    true,
+			},
+			"user_count": schema.Int64Attribute{
+				Description: "The number of users assigned to the role.",
+				Computed:    true,
+			},
+			"permissions": schema.MapNestedAttribute{
+				Description: "The enabled permissions of the role, keyed by action (e.g., 'api::article.article.find').",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"enabled": schema.BoolAttribute{
+							Description: "Whether the action is allowed.",
+							Computed:    true,
+						},
+						"policy": schema.StringAttribute{
+							Description: "The policy applied to the action.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The creation timestamp of the role.",
+				Computed:    true,
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The last update timestamp of the role.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *RoleDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+			path.MatchRoot("type"),
+		),
+	}
+}
+
+func (d *RoleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.StrapiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.StrapiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RoleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config RoleDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var role *client.Role
+	var err error
+	switch {
+	case !config.Name.IsNull():
+		role, err = d.client.FindRoleByName(ctx, config.Name.ValueString())
+	case !config.Type.IsNull():
+		role, err = d.client.FindRoleByType(ctx, config.Type.ValueString())
+	default:
+		var id int
+		id, err = strconv.Atoi(config.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error parsing role ID",
+				fmt.Sprintf("Could not parse role ID '%s': %s", config.ID.ValueString(), err),
+			)
+			return
+		}
+		role, err = d.client.FindRoleByID(ctx, id)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading role",
+			fmt.Sprintf("Could not find role: %s", err),
+		)
+		return
+	}
+
+	// The list of roles does not include permissions, so they are read from the role itself
+	details, err := d.client.GetRole(ctx, role.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading role permissions",
+			fmt.Sprintf("Could not read permissions of role with ID %d: %s", role.ID, err),
+		)
+		return
+	}
+
+	state := RoleDataSourceModel{
+		ID:          types.StringValue(strconv.Itoa(role.ID)),
+		Name:        types.StringValue(role.Name),
+		Type:        types.StringValue(role.Type),
+		Description: types.StringValue(role.Description),
+		UserCount:   types.Int64Value(int64(role.NbUsers)),
+		Permissions: flattenRolePermissions(client.FlattenPermissions(details.Permissions), nil),
+		CreatedAt:   types.StringValue(role.CreatedAt),
+		UpdatedAt:   types.StringValue(role.UpdatedAt),
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Read role with ID: %d", role.ID))
+}
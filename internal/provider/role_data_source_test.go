@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRoleDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.strapi_role.by_type", "name", "Authenticated"),
+					resource.TestCheckResourceAttrSet("data.strapi_role.by_type", "user_count"),
+					resource.TestCheckResourceAttrPair("data.strapi_role.by_name", "id", "data.strapi_role.by_type", "id"),
+					resource.TestCheckResourceAttr("data.strapi_role.by_id", "name", "tf_lookup_role"),
+					resource.TestCheckResourceAttr("data.strapi_role.by_id", "description", "Role looked up by ID"),
+					resource.TestCheckResourceAttr("data.strapi_role.by_id", "user_count", "0"),
+					resource.TestCheckResourceAttr("data.strapi_role.by_id", "permissions.%", "1"),
+					resource.TestCheckResourceAttr("data.strapi_role.by_id", "permissions.plugin::users-permissions.user.me.enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+const testAccRoleDataSourceConfig = `
+resource "strapi_role" "test" {
+  name        = "tf_lookup_role"
+  description = "Role looked up by ID"
+
+  permissions = {
+    "plugin::users-permissions.user.me" = {}
+  }
+}
+
+data "strapi_role" "by_type" {
+  type = "authenticated"
+}
+
+data "strapi_role" "by_name" {
+  name = "authenticated"
+}
+
+data "strapi_role" "by_id" {
+  id = strapi_role.test.id
+}
+`
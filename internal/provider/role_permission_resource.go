@@ -0,0 +1,268 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/fbritoferreira/terraform-provider-strapi/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &RolePermissionResource{}
+var _ resource.ResourceWithImportState = &RolePermissionResource{}
+
+type RolePermissionResource struct {
+	client *client.StrapiClient
+}
+
+type RolePermissionResourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	RoleID types.String `tfsdk:"role_id"`
+	Action types.String `tfsdk:"action"`
+	Policy types.String `tfsdk:"policy"`
+}
+
+func NewRolePermissionResource() resource.Resource {
+	return &RolePermissionResource{}
+}
+
+func (r *RolePermissionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_permission"
+}
+
+func (r *RolePermissionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Grants a single action to a Strapi users-permissions role. " +
+			"Other permissions of the role are left untouched, so several configurations can grant access on the same role. " +
+			"Do not combine with the `permissions` attribute of `strapi_role` for the same role.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the permission in the form `<role_id>/<action>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the role to grant the action to. Changing this forces a new permission.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"action": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The action to grant (e.g., 'api::article.article.find'). Changing this forces a new permission.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The policy applied to the action.",
+			},
+		},
+	}
+}
+
+func (r *RolePermissionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.StrapiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.StrapiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RolePermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RolePermissionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleID, err := strconv.Atoi(plan.RoleID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing role ID",
+			fmt.Sprintf("Could not parse role ID '%s': %s", plan.RoleID.ValueString(), err),
+		)
+		return
+	}
+
+	_, err = r.client.UpdateRolePermissions(ctx, roleID, map[string]client.Permission{
+		plan.Action.ValueString(): {Enabled: true, Policy: plan.Policy.ValueString()},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating role permission",
+			fmt.Sprintf("Could not grant '%s' to role %d: %s", plan.Action.ValueString(), roleID, err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(rolePermissionID(plan.RoleID.ValueString(), plan.Action.ValueString()))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Granted %s to role with ID: %d", plan.Action.ValueString(), roleID))
+}
+
+func (r *RolePermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RolePermissionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleID, err := strconv.Atoi(state.RoleID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing role ID",
+			fmt.Sprintf("Could not parse role ID '%s': %s", state.RoleID.ValueString(), err),
+		)
+		return
+	}
+
+	role, err := r.client.GetRole(ctx, roleID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Role with ID %d not found, removing permission %s from state", roleID, state.Action.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading role",
+			fmt.Sprintf("Could not read role: %s", err),
+		)
+		return
+	}
+
+	permission, ok := client.FlattenPermissions(role.Permissions)[state.Action.ValueString()]
+	if !ok || !permission.Enabled {
+		tflog.Info(ctx, fmt.Sprintf("Permission %s is no longer granted to role with ID: %d, removing from state", state.Action.ValueString(), roleID))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(rolePermissionID(state.RoleID.ValueString(), state.Action.ValueString()))
+	state.Policy = optionalStringValue(permission.Policy, state.Policy)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Read permission %s of role with ID: %d", state.Action.ValueString(), roleID))
+}
+
+func (r *RolePermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan RolePermissionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleID, err := strconv.Atoi(plan.RoleID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing role ID",
+			fmt.Sprintf("Could not parse role ID '%s': %s", plan.RoleID.ValueString(), err),
+		)
+		return
+	}
+
+	_, err = r.client.UpdateRolePermissions(ctx, roleID, map[string]client.Permission{
+		plan.Action.ValueString(): {Enabled: true, Policy: plan.Policy.ValueString()},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating role permission",
+			fmt.Sprintf("Could not update '%s' on role %d: %s", plan.Action.ValueString(), roleID, err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Updated permission %s of role with ID: %d", plan.Action.ValueString(), roleID))
+}
+
+func (r *RolePermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RolePermissionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleID, err := strconv.Atoi(state.RoleID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing role ID",
+			fmt.Sprintf("Could not parse role ID '%s': %s", state.RoleID.ValueString(), err),
+		)
+		return
+	}
+
+	_, err = r.client.UpdateRolePermissions(ctx, roleID, map[string]client.Permission{
+		state.Action.ValueString(): {Enabled: false},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting role permission",
+			fmt.Sprintf("Could not revoke '%s' from role %d: %s", state.Action.ValueString(), roleID, err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Revoked %s from role with ID: %d", state.Action.ValueString(), roleID))
+}
+
+func (r *RolePermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <role_id>/<action>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("action"), parts[1])...)
+}
+
+func rolePermissionID(roleID, action string) string {
+	return roleID + "/" + action
+}
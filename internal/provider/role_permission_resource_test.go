@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRolePermissionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRolePermissionResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("strapi_role_permission.me", "action", "plugin::users-permissions.user.me"),
+					resource.TestCheckResourceAttr("strapi_role_permission.find_roles", "action", "plugin::users-permissions.role.find"),
+					resource.TestCheckResourceAttrSet("strapi_role_permission.me", "id"),
+				),
+			},
+			{
+				ResourceName:      "strapi_role_permission.me",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccRolePermissionResourceConfig = `
+resource "strapi_role" "test" {
+  name        = "Terraform Role Permission Test"
+  description = "Role used by the role permission acceptance test"
+}
+
+resource "strapi_role_permission" "me" {
+  role_id = strapi_role.test.id
+  action  = "plugin::users-permissions.user.me"
+}
+
+resource "strapi_role_permission" "find_roles" {
+  role_id = strapi_role.test.id
+  action  = "plugin::users-permissions.role.find"
+}
+`
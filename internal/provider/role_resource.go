@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -22,12 +23,18 @@ type RoleResource struct {
 }
 
 type RoleResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Type        types.String `tfsdk:"type"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	UpdatedAt   types.String `tfsdk:"updated_at"`
+	ID          types.String                   `tfsdk:"id"`
+	Name        types.String                   `tfsdk:"name"`
+	Description types.String                   `tfsdk:"description"`
+	Type        types.String                   `tfsdk:"type"`
+	CreatedAt   types.String                   `tfsdk:"created_at"`
+	UpdatedAt   types.String                   `tfsdk:"updated_at"`
+	Permissions map[string]RolePermissionModel `tfsdk:"permissions"`
+}
+
+type RolePermissionModel struct {
+	Enabled types.Bool   `tfsdk:"enabled"`
+	Policy  types.String `tfsdk:"policy"`
 }
 
 func NewRoleResource() resource.Resource {
@@ -71,6 +78,26 @@ func (r *RoleResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Computed:            true,
 				MarkdownDescription: "The last update timestamp of the role.",
 			},
+			"permissions": schema.MapNestedAttribute{
+				Optional: true,
+				MarkdownDescription: "The permissions of the role, keyed by action (e.g., 'api::article.article.find'). " +
+					"When set, the permissions are authoritative: every action not listed here is disabled. " +
+					"When omitted, the permissions of the role are not managed.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"enabled": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+							MarkdownDescription: "Whether the action is allowed. Defaults to `true`.",
+						},
+						"policy": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The policy applied to the action.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -94,7 +121,7 @@ func (r *RoleResource) Configure(ctx context.Context, req resource.ConfigureRequ
 
 func (r *RoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan RoleResourceModel
-	diags := req.Config.Get(ctx, &plan)
+	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -106,7 +133,7 @@ func (r *RoleResource) Create(ctx context.Context, req resource.CreateRequest, r
 		Type:        plan.Type.ValueString(),
 	}
 
-	createdRole, err := r.client.CreateRole(role)
+	createdRole, err := r.client.CreateRole(ctx, role)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating role",
@@ -122,6 +149,20 @@ func (r *RoleResource) Create(ctx context.Context, req resource.CreateRequest, r
 	plan.CreatedAt = types.StringValue(createdRole.CreatedAt)
 	plan.UpdatedAt = types.StringValue(createdRole.UpdatedAt)
 
+	if plan.Permissions != nil {
+		if err := r.createRolePermissions(ctx, createdRole.ID, role, plan.Permissions); err != nil {
+			resp.Diagnostics.AddError(
+				"Error setting role permissions",
+				fmt.Sprintf("Could not set role permissions: %s", err),
+			)
+
+			// The role exists in Strapi, so it is saved without permissions for Terraform to track and taint it.
+			plan.Permissions = nil
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			return
+		}
+	}
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -131,6 +172,22 @@ func (r *RoleResource) Create(ctx context.Context, req resource.CreateRequest, r
 	tflog.Info(ctx, fmt.Sprintf("Created role with ID: %d", createdRole.ID))
 }
 
+// createRolePermissions sets the permissions of a newly created role, which Strapi does not accept on creation.
+func (r *RoleResource) createRolePermissions(ctx context.Context, id int, role client.Role, permissions map[string]RolePermissionModel) error {
+	currentRole, err := r.client.GetRole(ctx, id)
+	if err != nil {
+		return fmt.Errorf("could not read role permissions: %w", err)
+	}
+
+	if err := client.ApplyPermissions(currentRole.Permissions, expandRolePermissions(permissions), true); err != nil {
+		return err
+	}
+
+	role.Permissions = currentRole.Permissions
+	_, err = r.client.UpdateRole(ctx, id, role)
+	return err
+}
+
 func (r *RoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state RoleResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -148,8 +205,14 @@ func (r *RoleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	role, err := r.client.GetRole(id)
+	role, err := r.client.GetRole(ctx, id)
 	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Role with ID %d not found, removing from state", id))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error reading role",
 			fmt.Sprintf("Could not read role: %s", err),
@@ -164,6 +227,10 @@ func (r *RoleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	state.CreatedAt = types.StringValue(role.CreatedAt)
 	state.UpdatedAt = types.StringValue(role.UpdatedAt)
 
+	if state.Permissions != nil {
+		state.Permissions = flattenRolePermissions(client.FlattenPermissions(role.Permissions), state.Permissions)
+	}
+
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -196,7 +263,29 @@ func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		Type:        plan.Type.ValueString(),
 	}
 
-	updatedRole, err := r.client.UpdateRole(id, role)
+	if plan.Permissions != nil {
+		currentRole, err := r.client.GetRole(ctx, id)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading role",
+				fmt.Sprintf("Could not read role permissions: %s", err),
+			)
+			return
+		}
+
+		err = client.ApplyPermissions(currentRole.Permissions, expandRolePermissions(plan.Permissions), true)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error setting role permissions",
+				fmt.Sprintf("Could not set role permissions: %s", err),
+			)
+			return
+		}
+
+		role.Permissions = currentRole.Permissions
+	}
+
+	updatedRole, err := r.client.UpdateRole(ctx, id, role)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating role",
@@ -237,7 +326,7 @@ func (r *RoleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	err = r.client.DeleteRole(id)
+	err = r.client.DeleteRole(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting role",
@@ -252,3 +341,35 @@ func (r *RoleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 func (r *RoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+func expandRolePermissions(models map[string]RolePermissionModel) map[string]client.Permission {
+	permissions := make(map[string]client.Permission, len(models))
+	for action, model := range models {
+		permissions[action] = client.Permission{
+			Enabled: model.Enabled.ValueBool(),
+			Policy:  model.Policy.ValueString(),
+		}
+	}
+	return permissions
+}
+
+// flattenRolePermissions converts the permissions of a role into their Terraform representation. Every enabled
+// action is included so that permissions granted outside Terraform show up as drift; disabled actions are only
+// kept when they are present in the prior state.
+func flattenRolePermissions(permissions map[string]client.Permission, prior map[string]RolePermissionModel) map[string]RolePermissionModel {
+	models := make(map[string]RolePermissionModel)
+
+	for action, permission := range permissions {
+		previous, managed := prior[action]
+		if !permission.Enabled && !managed {
+			continue
+		}
+
+		models[action] = RolePermissionModel{
+			Enabled: types.BoolValue(permission.Enabled),
+			Policy:  optionalStringValue(permission.Policy, previous.Policy),
+		}
+	}
+
+	return models
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRoleResourcePermissions(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleResourcePermissionsConfig("plugin::users-permissions.user.me", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("strapi_role.test", "name", "Terraform Permissions Test"),
+					resource.TestCheckResourceAttr("strapi_role.test", "permissions.%", "1"),
+					resource.TestCheckResourceAttr("strapi_role.test", "permissions.plugin::users-permissions.user.me.enabled", "true"),
+				),
+			},
+			{
+				Config: testAccRoleResourcePermissionsConfig("plugin::users-permissions.role.find", "enabled = true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("strapi_role.test", "permissions.%", "1"),
+					resource.TestCheckResourceAttr("strapi_role.test", "permissions.plugin::users-permissions.role.find.enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+// testAccRoleResourcePermissionsConfig grants a single action, leaving enabled to its default when attributes is empty
+func testAccRoleResourcePermissionsConfig(action, attributes string) string {
+	return fmt.Sprintf(`
+resource "strapi_role" "test" {
+  name        = "Terraform Permissions Test"
+  description = "Role used by the permissions acceptance test"
+
+  permissions = {
+    %[1]q = {
+      %[2]s
+    }
+  }
+}
+`, action, attributes)
+}
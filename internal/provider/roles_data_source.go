@@ -98,7 +98,7 @@ func (d *RolesDataSource) Configure(ctx context.Context, req datasource.Configur
 func (d *RolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state RolesDataSourceModel
 
-	roles, err := d.client.GetRoles()
+	roles, err := d.client.GetRoles(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading roles",
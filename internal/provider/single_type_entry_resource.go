@@ -0,0 +1,263 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fbritoferreira/terraform-provider-strapi/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	deletionPolicyDelete = "delete"
+	deletionPolicyRetain = "retain"
+)
+
+var _ resource.Resource = &SingleTypeEntryResource{}
+var _ resource.ResourceWithImportState = &SingleTypeEntryResource{}
+
+type SingleTypeEntryResource struct {
+	client *client.StrapiClient
+}
+
+type SingleTypeEntryResourceModel struct {
+	ID             types.String         `tfsdk:"id"`
+	ContentType    types.String         `tfsdk:"content_type"`
+	DocumentID     types.String         `tfsdk:"document_id"`
+	Data           jsontypes.Normalized `tfsdk:"data"`
+	Status         types.String         `tfsdk:"status"`
+	DeletionPolicy types.String         `tfsdk:"deletion_policy"`
+}
+
+func NewSingleTypeEntryResource() resource.Resource {
+	return &SingleTypeEntryResource{}
+}
+
+func (r *SingleTypeEntryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_single_type_entry"
+}
+
+func (r *SingleTypeEntryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the content of a Strapi single type (e.g., homepage, global settings) through the content API.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the resource, equal to the singular API ID of the single type.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"content_type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The singular API ID of the single type (e.g., 'homepage'). Changing this forces a new resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"document_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The document ID of the single type content.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"data": schema.StringAttribute{
+				Required:            true,
+				CustomType:          jsontypes.NormalizedType{},
+				MarkdownDescription: "The JSON-encoded fields of the single type. Only the fields present here are managed and checked for drift.",
+			},
+			"status": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("published"),
+				MarkdownDescription: "The draft and publish status to write and read, either 'published' or 'draft'. Defaults to 'published'. Ignored when draft and publish is disabled on the single type.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("published", "draft"),
+				},
+			},
+			"deletion_policy": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(deletionPolicyDelete),
+				MarkdownDescription: "What to do with the content when the resource is destroyed: 'delete' removes it from Strapi, 'retain' only removes it from the Terraform state. Defaults to 'delete'.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(deletionPolicyDelete, deletionPolicyRetain),
+				},
+			},
+		},
+	}
+}
+
+func (r *SingleTypeEntryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.StrapiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.StrapiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SingleTypeEntryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SingleTypeEntryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.put(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Created single type entry: %s", plan.ContentType.ValueString()))
+}
+
+func (r *SingleTypeEntryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SingleTypeEntryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entry, err := r.client.GetSingleTypeEntry(ctx, state.ContentType.ValueString(), state.Status.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Single type entry %s not found, removing from state", state.ContentType.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading single type entry",
+			fmt.Sprintf("Could not read single type entry: %s", err),
+		)
+		return
+	}
+
+	data, err := flattenEntryData(entry.Data, state.Data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error encoding single type entry data",
+			fmt.Sprintf("Could not encode single type entry data: %s", err),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(state.ContentType.ValueString())
+	state.DocumentID = types.StringValue(entry.DocumentID)
+	state.Data = data
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Read single type entry: %s", state.ContentType.ValueString()))
+}
+
+func (r *SingleTypeEntryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan SingleTypeEntryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.put(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Updated single type entry: %s", plan.ContentType.ValueString()))
+}
+
+func (r *SingleTypeEntryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SingleTypeEntryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.DeletionPolicy.ValueString() == deletionPolicyRetain {
+		tflog.Info(ctx, fmt.Sprintf("Retained single type entry: %s", state.ContentType.ValueString()))
+		return
+	}
+
+	err := r.client.DeleteSingleTypeEntry(ctx, state.ContentType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting single type entry",
+			fmt.Sprintf("Could not delete single type entry: %s", err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Deleted single type entry: %s", state.ContentType.ValueString()))
+}
+
+func (r *SingleTypeEntryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("content_type"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("status"), "published")...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_policy"), deletionPolicyDelete)...)
+}
+
+// put upserts the single type content described by the model and records the resulting document ID.
+func (r *SingleTypeEntryResource) put(ctx context.Context, model *SingleTypeEntryResourceModel, diags *diag.Diagnostics) {
+	data, err := expandEntryData(model.Data)
+	if err != nil {
+		diags.AddError(
+			"Error parsing single type entry data",
+			fmt.Sprintf("Could not parse single type entry data: %s", err),
+		)
+		return
+	}
+
+	entry, err := r.client.PutSingleTypeEntry(ctx, model.ContentType.ValueString(), data, model.Status.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error updating single type entry",
+			fmt.Sprintf("Could not update single type entry: %s", err),
+		)
+		return
+	}
+
+	model.ID = types.StringValue(model.ContentType.ValueString())
+	model.DocumentID = types.StringValue(entry.DocumentID)
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSingleTypeEntryResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSingleTypeEntryResourceConfig("Welcome"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("strapi_single_type_entry.test", "id", "tfacc-homepage"),
+					resource.TestCheckResourceAttr("strapi_single_type_entry.test", "status", "published"),
+					resource.TestCheckResourceAttrSet("strapi_single_type_entry.test", "document_id"),
+				),
+			},
+			{
+				ResourceName:            "strapi_single_type_entry.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"data"},
+			},
+			{
+				Config: testAccSingleTypeEntryResourceConfig("Welcome back"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("strapi_single_type_entry.test", "data", `{"headline":"Welcome back"}`),
+				),
+			},
+		},
+	})
+}
+
+func testAccSingleTypeEntryResourceConfig(headline string) string {
+	return fmt.Sprintf(`
+resource "strapi_content_type" "test" {
+  kind          = "singleType"
+  singular_name = "tfacc-homepage"
+  plural_name   = "tfacc-homepages"
+  display_name  = "Test Homepage"
+
+  attributes = {
+    headline = {
+      type = "string"
+    }
+  }
+}
+
+resource "strapi_single_type_entry" "test" {
+  content_type = strapi_content_type.test.singular_name
+  data = jsonencode({
+    headline = %[1]q
+  })
+}
+`, headline)
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const millisecondsPerDay = 24 * 60 * 60 * 1000
+
+// tokenLifecycleAttributes returns the schema attributes shared by the API and transfer token resources, merged
+// with the attributes specific to a resource. The kind names the token in descriptions (e.g., "API token").
+func tokenLifecycleAttributes(kind string, attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	shared := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: fmt.Sprintf("The ID of the %s.", kind),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: fmt.Sprintf("The name of the %s. Must be unique.", kind),
+		},
+		"description": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: fmt.Sprintf("The description of the %s.", kind),
+		},
+		"lifespan": schema.Int64Attribute{
+			Optional:            true,
+			MarkdownDescription: fmt.Sprintf("The lifespan of the %s in days: 7, 30 or 90. The token never expires when omitted. Changing this forces a new token.", kind),
+			Validators: []validator.Int64{
+				int64validator.OneOf(7, 30, 90),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"regenerate_trigger": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "An arbitrary value that regenerates the access key whenever it changes (e.g., a rotation date).",
+		},
+		"expires_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: fmt.Sprintf("Timestamp when the %s expires. Empty when the token never expires.", kind),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"expired": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: fmt.Sprintf("Whether the %s has expired. An expired token is replaced on the next apply.", kind),
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"created_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: fmt.Sprintf("Timestamp when the %s was created.", kind),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+
+	maps.Copy(shared, attributes)
+	return shared
+}
+
+// modifyTokenPlan marks the access key as unknown when the regenerate trigger changes, and forces the replacement
+// of tokens that have expired.
+func modifyTokenPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var expired types.Bool
+	var planTrigger, stateTrigger types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("expired"), &expired)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("regenerate_trigger"), &planTrigger)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("regenerate_trigger"), &stateTrigger)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if expired.ValueBool() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expired"), types.BoolValue(false))...)
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("expired"))
+		return
+	}
+
+	if shouldRegenerate(planTrigger, stateTrigger) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("access_key"), types.StringUnknown())...)
+	}
+}
+
+// lifespanMilliseconds converts a lifespan in days into the lifespan in milliseconds expected by Strapi.
+func lifespanMilliseconds(days types.Int64) json.Number {
+	if days.IsNull() || days.IsUnknown() {
+		return ""
+	}
+	return json.Number(strconv.FormatInt(days.ValueInt64()*millisecondsPerDay, 10))
+}
+
+// lifespanDays converts a lifespan in milliseconds returned by Strapi into days.
+func lifespanDays(milliseconds json.Number) types.Int64 {
+	value, err := milliseconds.Int64()
+	if err != nil || value == 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(value / millisecondsPerDay)
+}
+
+// tokenExpired reports whether a token expiry timestamp lies in the past. Tokens without an expiry never expire.
+func tokenExpired(expiresAt string) bool {
+	if expiresAt == "" {
+		return false
+	}
+
+	expiry, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return false
+	}
+
+	return !expiry.After(time.Now())
+}
+
+// shouldRegenerate reports whether a token must be regenerated because its regenerate trigger was set to a new value.
+func shouldRegenerate(plan, state types.String) bool {
+	return !plan.IsNull() && !plan.Equal(state)
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestLifespanConversion(t *testing.T) {
+	if got := lifespanMilliseconds(types.Int64Value(30)); got != "2592000000" {
+		t.Errorf("expected 30 days to be 2592000000 milliseconds, got %s", got)
+	}
+	if got := lifespanMilliseconds(types.Int64Null()); got != "" {
+		t.Errorf("expected an empty lifespan for a token that never expires, got %s", got)
+	}
+
+	if got := lifespanDays(json.Number("2592000000")); !got.Equal(types.Int64Value(30)) {
+		t.Errorf("expected 2592000000 milliseconds to be 30 days, got %s", got)
+	}
+	if got := lifespanDays(""); !got.IsNull() {
+		t.Errorf("expected a null lifespan for a token that never expires, got %s", got)
+	}
+}
+
+func TestTokenExpired(t *testing.T) {
+	tests := map[string]bool{
+		"": false,
+		time.Now().Add(-time.Hour).Format(time.RFC3339): true,
+		time.Now().Add(time.Hour).Format(time.RFC3339):  false,
+	}
+
+	for expiresAt, expected := range tests {
+		if got := tokenExpired(expiresAt); got != expected {
+			t.Errorf("expected tokenExpired(%q) to be %t, got %t", expiresAt, expected, got)
+		}
+	}
+}
@@ -0,0 +1,280 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fbritoferreira/terraform-provider-strapi/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	transferTokenPermissionPush = "push"
+	transferTokenPermissionPull = "pull"
+)
+
+var _ resource.Resource = &TransferTokenResource{}
+var _ resource.ResourceWithImportState = &TransferTokenResource{}
+var _ resource.ResourceWithModifyPlan = &TransferTokenResource{}
+
+type TransferTokenResource struct {
+	client *client.StrapiClient
+}
+
+type TransferTokenResourceModel struct {
+	ID                types.String   `tfsdk:"id"`
+	Name              types.String   `tfsdk:"name"`
+	Description       types.String   `tfsdk:"description"`
+	Lifespan          types.Int64    `tfsdk:"lifespan"`
+	Permissions       []types.String `tfsdk:"permissions"`
+	RegenerateTrigger types.String   `tfsdk:"regenerate_trigger"`
+	AccessKey         types.String   `tfsdk:"access_key"`
+	ExpiresAt         types.String   `tfsdk:"expires_at"`
+	Expired           types.Bool     `tfsdk:"expired"`
+	CreatedAt         types.String   `tfsdk:"created_at"`
+}
+
+func NewTransferTokenResource() resource.Resource {
+	return &TransferTokenResource{}
+}
+
+func (r *TransferTokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_transfer_token"
+}
+
+func (r *TransferTokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Strapi data transfer token, used by `strapi transfer` to push or pull data between instances. " +
+			"The access key is only returned by Strapi when the token is created or regenerated, so it is stored in the Terraform state as a sensitive value.",
+		Attributes: tokenLifecycleAttributes("transfer token", map[string]schema.Attribute{
+			"permissions": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The transfer directions allowed with the transfer token: 'push', 'pull' or both.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(transferTokenPermissionPush, transferTokenPermissionPull)),
+				},
+			},
+			"access_key": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The access key of the transfer token, passed to `strapi transfer` with `--to-token` or `--from-token`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		}),
+	}
+}
+
+func (r *TransferTokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.StrapiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.StrapiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan regenerates the access key or replaces the token as decided by modifyTokenPlan.
+func (r *TransferTokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyTokenPlan(ctx, req, resp)
+}
+
+func (r *TransferTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TransferTokenResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := r.client.CreateTransferToken(ctx, expandTransferToken(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating transfer token",
+			fmt.Sprintf("Could not create transfer token: %s", err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.Itoa(token.ID))
+	plan.AccessKey = types.StringValue(token.AccessKey)
+	plan.ExpiresAt = types.StringValue(token.ExpiresAt)
+	plan.Expired = types.BoolValue(tokenExpired(token.ExpiresAt))
+	plan.CreatedAt = types.StringValue(token.CreatedAt)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Created transfer token with ID: %d", token.ID))
+}
+
+func (r *TransferTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TransferTokenResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing transfer token ID",
+			fmt.Sprintf("Could not parse transfer token ID '%s': %s", state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	token, err := r.client.GetTransferToken(ctx, id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Transfer token with ID %d not found, removing from state", id))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading transfer token",
+			fmt.Sprintf("Could not read transfer token: %s", err),
+		)
+		return
+	}
+
+	state.Name = types.StringValue(token.Name)
+	state.Description = optionalStringValue(token.Description, state.Description)
+	state.Lifespan = lifespanDays(token.Lifespan)
+	state.Permissions = optionalStringValues(token.Permissions, state.Permissions)
+	state.ExpiresAt = types.StringValue(token.ExpiresAt)
+	state.Expired = types.BoolValue(tokenExpired(token.ExpiresAt))
+	state.CreatedAt = types.StringValue(token.CreatedAt)
+
+	if token.AccessKey != "" {
+		state.AccessKey = types.StringValue(token.AccessKey)
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Read transfer token with ID: %d", token.ID))
+}
+
+func (r *TransferTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state TransferTokenResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing transfer token ID",
+			fmt.Sprintf("Could not parse transfer token ID '%s': %s", state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	token, err := r.client.UpdateTransferToken(ctx, id, expandTransferToken(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating transfer token",
+			fmt.Sprintf("Could not update transfer token: %s", err),
+		)
+		return
+	}
+
+	if shouldRegenerate(plan.RegenerateTrigger, state.RegenerateTrigger) {
+		regenerated, err := r.client.RegenerateTransferToken(ctx, id)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error regenerating transfer token",
+				fmt.Sprintf("Could not regenerate transfer token: %s", err),
+			)
+			return
+		}
+
+		plan.AccessKey = types.StringValue(regenerated.AccessKey)
+		tflog.Info(ctx, fmt.Sprintf("Regenerated access key of transfer token with ID: %d", id))
+	}
+
+	plan.ExpiresAt = types.StringValue(token.ExpiresAt)
+	plan.Expired = types.BoolValue(tokenExpired(token.ExpiresAt))
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Updated transfer token with ID: %d", id))
+}
+
+func (r *TransferTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TransferTokenResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing transfer token ID",
+			fmt.Sprintf("Could not parse transfer token ID '%s': %s", state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	err = r.client.DeleteTransferToken(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting transfer token",
+			fmt.Sprintf("Could not delete transfer token: %s", err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Deleted transfer token with ID: %d", id))
+}
+
+func (r *TransferTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func expandTransferToken(plan TransferTokenResourceModel) client.TransferToken {
+	return client.TransferToken{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		Lifespan:    lifespanMilliseconds(plan.Lifespan),
+		Permissions: stringValues(plan.Permissions),
+	}
+}
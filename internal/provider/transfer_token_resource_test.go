@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTransferTokenResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransferTokenResourceConfig(`["push"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("strapi_transfer_token.test", "name", "Terraform Transfer"),
+					resource.TestCheckResourceAttr("strapi_transfer_token.test", "lifespan", "7"),
+					resource.TestCheckResourceAttr("strapi_transfer_token.test", "permissions.#", "1"),
+					resource.TestCheckResourceAttr("strapi_transfer_token.test", "expired", "false"),
+					resource.TestCheckResourceAttrSet("strapi_transfer_token.test", "access_key"),
+				),
+			},
+			{
+				ResourceName:            "strapi_transfer_token.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"access_key"},
+			},
+			{
+				Config: testAccTransferTokenResourceConfig(`["push", "pull"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("strapi_transfer_token.test", "permissions.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransferTokenResourceConfig(permissions string) string {
+	return fmt.Sprintf(`
+resource "strapi_transfer_token" "test" {
+  name        = "Terraform Transfer"
+  description = "Token used by the transfer token acceptance test"
+  lifespan    = 7
+  permissions = %[1]s
+}
+`, permissions)
+}
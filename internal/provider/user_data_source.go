@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fbritoferreira/terraform-provider-strapi/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &UserDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &UserDataSource{}
+
+type UserDataSource struct {
+	client *client.StrapiClient
+}
+
+type UserDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	DocumentID types.String `tfsdk:"document_id"`
+	Email      types.String `tfsdk:"email"`
+	Username   types.String `tfsdk:"username"`
+	Confirmed  types.Bool   `tfsdk:"confirmed"`
+	Blocked    types.Bool   `tfsdk:"blocked"`
+	RoleID     types.Int64  `tfsdk:"role_id"`
+	RoleName   types.String `tfsdk:"role_name"`
+	RoleType   types.String `tfsdk:"role_type"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	UpdatedAt  types.String `tfsdk:"updated_at"`
+}
+
+func NewUserDataSource() datasource.DataSource {
+	return &UserDataSource{}
+}
+
+func (d *UserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *UserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a single Strapi users-permissions user by ID, document ID, email or username.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the user.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"document_id": schema.StringAttribute{
+				Description: "The document ID of the user.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"email": schema.StringAttribute{
+				Description: "The email address of the user.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "The username of the user.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"confirmed": schema.BoolAttribute{
+				Description: "Whether the user has confirmed their email.",
+				Computed:    true,
+			},
+			"blocked": schema.BoolAttribute{
+				Description: "Whether the user is blocked.",
+				Computed:    true,
+			},
+			"role_id": schema.Int64Attribute{
+				Description: "The ID of the role of the user.",
+				Computed:    true,
+			},
+			"role_name": schema.StringAttribute{
+				Description: "The name of the role of the user.",
+				Computed:    true,
+			},
+			"role_type": schema.StringAttribute{
+				Description: "The type of the role of the user (e.g., 'authenticated').",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The creation timestamp of the user.",
+				Computed:    true,
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The last update timestamp of the user.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *UserDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("document_id"),
+			path.MatchRoot("email"),
+			path.MatchRoot("username"),
+		),
+	}
+}
+
+func (d *UserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.StrapiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.StrapiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config UserDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	field, value := "id", config.ID.ValueString()
+	switch {
+	case !config.DocumentID.IsNull():
+		field, value = "documentId", config.DocumentID.ValueString()
+	case !config.Email.IsNull():
+		field, value = "email", config.Email.ValueString()
+	case !config.Username.IsNull():
+		field, value = "username", config.Username.ValueString()
+	}
+
+	user, err := d.client.FindUser(ctx, field, value)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading user",
+			fmt.Sprintf("Could not find user with %s '%s': %s", field, value, err),
+		)
+		return
+	}
+
+	state := flattenUser(*user)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Read user with ID: %d", user.ID))
+}
+
+// flattenUser converts a user with its populated role into its data source representation.
+func flattenUser(user client.User) UserDataSourceModel {
+	model := UserDataSourceModel{
+		ID:         types.StringValue(strconv.Itoa(user.ID)),
+		DocumentID: types.StringValue(user.DocumentID),
+		Email:      types.StringValue(user.Email),
+		Username:   types.StringValue(user.Username),
+		Confirmed:  types.BoolValue(user.Confirmed),
+		Blocked:    types.BoolValue(user.Blocked),
+		RoleID:     types.Int64Null(),
+		RoleName:   types.StringNull(),
+		RoleType:   types.StringNull(),
+		CreatedAt:  types.StringValue(user.CreatedAt),
+		UpdatedAt:  types.StringValue(user.UpdatedAt),
+	}
+
+	if id, ok := user.Role["id"].(float64); ok {
+		model.RoleID = types.Int64Value(int64(id))
+	}
+	if name, ok := user.Role["name"].(string); ok {
+		model.RoleName = types.StringValue(name)
+	}
+	if roleType, ok := user.Role["type"].(string); ok {
+		model.RoleType = types.StringValue(roleType)
+	}
+
+	return model
+}
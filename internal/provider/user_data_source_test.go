@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.strapi_user.by_email", "id", "strapi_user.test", "id"),
+					resource.TestCheckResourceAttr("data.strapi_user.by_email", "username", "tf_lookup_user"),
+					resource.TestCheckResourceAttr("data.strapi_user.by_email", "confirmed", "true"),
+					resource.TestCheckResourceAttr("data.strapi_user.by_email", "blocked", "false"),
+					resource.TestCheckResourceAttr("data.strapi_user.by_email", "role_name", "Authenticated"),
+					resource.TestCheckResourceAttr("data.strapi_user.by_email", "role_type", "authenticated"),
+					resource.TestCheckResourceAttrPair("data.strapi_user.by_username", "email", "strapi_user.test", "email"),
+					resource.TestCheckResourceAttrPair("data.strapi_user.by_id", "document_id", "data.strapi_user.by_email", "document_id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccUserDataSourceConfig = `
+resource "strapi_user" "test" {
+  username  = "tf_lookup_user"
+  email     = "tf_lookup_user@example.com"
+  password  = "TestPass123!"
+  confirmed = true
+  role_name = "Authenticated"
+}
+
+data "strapi_user" "by_email" {
+  email = strapi_user.test.email
+}
+
+data "strapi_user" "by_username" {
+  username = strapi_user.test.username
+}
+
+data "strapi_user" "by_id" {
+  id = strapi_user.test.id
+}
+`
@@ -4,32 +4,42 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/fbritoferreira/terraform-provider-strapi/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = &UserResource{}
+var _ resource.ResourceWithConfigValidators = &UserResource{}
+var _ resource.ResourceWithModifyPlan = &UserResource{}
 
 type UserResource struct {
 	client *client.StrapiClient
 }
 
 type UserResourceModel struct {
-	ID         types.String `tfsdk:"id"`
-	DocumentID types.String `tfsdk:"document_id"`
-	Username   types.String `tfsdk:"username"`
-	Email      types.String `tfsdk:"email"`
-	Confirmed  types.Bool   `tfsdk:"confirmed"`
-	Blocked    types.Bool   `tfsdk:"blocked"`
-	RoleName   types.String `tfsdk:"role_name"`
-	RoleID     types.Int64  `tfsdk:"role_id"`
+	ID              types.String `tfsdk:"id"`
+	DocumentID      types.String `tfsdk:"document_id"`
+	Username        types.String `tfsdk:"username"`
+	Email           types.String `tfsdk:"email"`
+	Password        types.String `tfsdk:"password"`
+	PasswordVersion types.Int64  `tfsdk:"password_version"`
+	Confirmed       types.Bool   `tfsdk:"confirmed"`
+	Blocked         types.Bool   `tfsdk:"blocked"`
+	RoleName        types.String `tfsdk:"role_name"`
+	RoleID          types.Int64  `tfsdk:"role_id"`
 }
 
 func NewUserResource() resource.Resource {
@@ -63,6 +73,22 @@ func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Required:            true,
 				MarkdownDescription: "The email address of the user.",
 			},
+			"password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "The password of the user. Required by Strapi when creating a user. This value is write-only and never stored in state; it is only sent on create and when `password_version` changes.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(6),
+				},
+			},
+			"password_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "A version number for `password`. Change it to send the current `password` to Strapi and rotate the password.",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password")),
+				},
+			},
 			"confirmed": schema.BoolAttribute{
 				Optional:            true,
 				Computed:            true,
@@ -75,17 +101,33 @@ func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 			},
 			"role_name": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "The name of the role to assign to the user.",
+				Computed:            true,
+				MarkdownDescription: "The name of the role to assign to the user. Conflicts with `role_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"role_id": schema.Int64Attribute{
 				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "The ID of the role assigned to the user.",
+				MarkdownDescription: "The ID of the role assigned to the user. Conflicts with `role_name`.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 		},
 	}
 }
 
+func (r *UserResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("role_name"),
+			path.MatchRoot("role_id"),
+		),
+	}
+}
+
 func (r *UserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -103,6 +145,27 @@ func (r *UserResource) Configure(ctx context.Context, req resource.ConfigureRequ
 	r.client = client
 }
 
+func (r *UserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config, state UserResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A role change is applied in place, and the attribute not set in the configuration is only known afterwards
+	if !config.RoleName.IsNull() && !config.RoleName.Equal(state.RoleName) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("role_id"), types.Int64Unknown())...)
+	}
+	if !config.RoleID.IsNull() && !config.RoleID.Equal(state.RoleID) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("role_name"), types.StringUnknown())...)
+	}
+}
+
 func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan UserResourceModel
 	diags := req.Config.Get(ctx, &plan)
@@ -114,12 +177,13 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 	user := client.User{
 		Username:  plan.Username.ValueString(),
 		Email:     plan.Email.ValueString(),
+		Password:  plan.Password.ValueString(),
 		Confirmed: plan.Confirmed.ValueBool(),
 		Blocked:   plan.Blocked.ValueBool(),
 	}
 
 	if !plan.RoleName.IsNull() {
-		role, err := r.client.FindRoleByName(plan.RoleName.ValueString())
+		role, err := r.client.FindRoleByName(ctx, plan.RoleName.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error finding role",
@@ -127,21 +191,12 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 			)
 			return
 		}
-		user.Role = map[string]interface{}{
-			"connect": []map[string]interface{}{
-				{"id": role.ID},
-			},
-		}
-		plan.RoleID = types.Int64Value(int64(role.ID))
+		user.Role = roleConnect(role.ID)
 	} else if !plan.RoleID.IsNull() {
-		user.Role = map[string]interface{}{
-			"connect": []map[string]interface{}{
-				{"id": int(plan.RoleID.ValueInt64())},
-			},
-		}
+		user.Role = roleConnect(int(plan.RoleID.ValueInt64()))
 	}
 
-	createdUser, err := r.client.CreateUser(user)
+	createdUser, err := r.client.CreateUser(ctx, user)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating user",
@@ -150,21 +205,19 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	plan.ID = types.StringValue(strconv.Itoa(createdUser.ID))
-	plan.DocumentID = types.StringValue(createdUser.DocumentID)
-	plan.Username = types.StringValue(createdUser.Username)
-	plan.Email = types.StringValue(createdUser.Email)
-	plan.Confirmed = types.BoolValue(createdUser.Confirmed)
-	plan.Blocked = types.BoolValue(createdUser.Blocked)
-
-	if createdUser.Role != nil {
-		if id, ok := createdUser.Role["id"].(float64); ok {
-			plan.RoleID = types.Int64Value(int64(id))
-		} else if id, ok := createdUser.Role["id"].(int); ok {
-			plan.RoleID = types.Int64Value(int64(id))
-		}
+	// The created user is read back to learn the role Strapi assigned
+	createdUser, err = r.client.GetUser(ctx, createdUser.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading user",
+			fmt.Sprintf("Could not read created user: %s", err),
+		)
+		return
 	}
 
+	flattenUserResource(&plan, createdUser)
+	plan.Password = types.StringNull()
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -191,8 +244,14 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	user, err := r.client.GetUser(id)
+	user, err := r.client.GetUser(ctx, id)
 	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("User with ID %d not found, removing from state", id))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
 		resp.Diagnostics.AddError(
 			"Error reading user",
 			fmt.Sprintf("Could not read user: %s", err),
@@ -200,12 +259,7 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	state.ID = types.StringValue(strconv.Itoa(user.ID))
-	state.DocumentID = types.StringValue(user.DocumentID)
-	state.Username = types.StringValue(user.Username)
-	state.Email = types.StringValue(user.Email)
-	state.Confirmed = types.BoolValue(user.Confirmed)
-	state.Blocked = types.BoolValue(user.Blocked)
+	flattenUserResource(&state, user)
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -217,9 +271,10 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan UserResourceModel
+	var plan, state UserResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -240,8 +295,19 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		Blocked:   plan.Blocked.ValueBool(),
 	}
 
-	if !plan.RoleName.IsNull() && plan.RoleName.ValueString() != "" {
-		role, err := r.client.FindRoleByName(plan.RoleName.ValueString())
+	// The password is write-only, so it is read from the configuration and only sent when its version changes
+	if !plan.PasswordVersion.Equal(state.PasswordVersion) {
+		var password types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		user.Password = password.ValueString()
+	}
+
+	// The role ID is unknown when the role name changed, so the new role is looked up by name
+	if plan.RoleID.IsUnknown() && !plan.RoleName.IsNull() && !plan.RoleName.IsUnknown() {
+		role, err := r.client.FindRoleByName(ctx, plan.RoleName.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error finding role",
@@ -249,21 +315,12 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 			)
 			return
 		}
-		user.Role = map[string]interface{}{
-			"connect": []map[string]interface{}{
-				{"id": role.ID},
-			},
-		}
-		plan.RoleID = types.Int64Value(int64(role.ID))
-	} else if !plan.RoleID.IsNull() {
-		user.Role = map[string]interface{}{
-			"connect": []map[string]interface{}{
-				{"id": int(plan.RoleID.ValueInt64())},
-			},
-		}
+		user.Role = roleConnect(role.ID)
+	} else if !plan.RoleID.IsNull() && !plan.RoleID.IsUnknown() {
+		user.Role = roleConnect(int(plan.RoleID.ValueInt64()))
 	}
 
-	updatedUser, err := r.client.UpdateUser(id, user)
+	_, err = r.client.UpdateUser(ctx, id, user)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating user",
@@ -272,21 +329,17 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	plan.ID = types.StringValue(strconv.Itoa(updatedUser.ID))
-	plan.DocumentID = types.StringValue(updatedUser.DocumentID)
-	plan.Username = types.StringValue(updatedUser.Username)
-	plan.Email = types.StringValue(updatedUser.Email)
-	plan.Confirmed = types.BoolValue(updatedUser.Confirmed)
-	plan.Blocked = types.BoolValue(updatedUser.Blocked)
-
-	if updatedUser.Role != nil {
-		if id, ok := updatedUser.Role["id"].(float64); ok {
-			plan.RoleID = types.Int64Value(int64(id))
-		} else if id, ok := updatedUser.Role["id"].(int); ok {
-			plan.RoleID = types.Int64Value(int64(id))
-		}
+	updatedUser, err := r.client.GetUser(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading user",
+			fmt.Sprintf("Could not read updated user: %s", err),
+		)
+		return
 	}
 
+	flattenUserResource(&plan, updatedUser)
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -313,7 +366,7 @@ func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	err = r.client.DeleteUser(id)
+	err = r.client.DeleteUser(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting user",
@@ -328,3 +381,36 @@ func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// roleConnect returns the relation connecting a user to a role
+func roleConnect(id int) map[string]interface{} {
+	return map[string]interface{}{
+		"connect": []interface{}{
+			map[string]interface{}{"id": id},
+		},
+	}
+}
+
+// flattenUserResource copies a user with its populated role into the resource model. The configured spelling
+// of the role name is kept when it matches the name of the role case-insensitively.
+func flattenUserResource(model *UserResourceModel, user *client.User) {
+	model.ID = types.StringValue(strconv.Itoa(user.ID))
+	model.DocumentID = types.StringValue(user.DocumentID)
+	model.Username = types.StringValue(user.Username)
+	model.Email = types.StringValue(user.Email)
+	model.Confirmed = types.BoolValue(user.Confirmed)
+	model.Blocked = types.BoolValue(user.Blocked)
+	model.RoleID = types.Int64Null()
+
+	if id, ok := user.Role["id"].(float64); ok {
+		model.RoleID = types.Int64Value(int64(id))
+	}
+
+	name, ok := user.Role["name"].(string)
+	switch {
+	case !ok:
+		model.RoleName = types.StringNull()
+	case model.RoleName.IsUnknown() || !strings.EqualFold(model.RoleName.ValueString(), name):
+		model.RoleName = types.StringValue(name)
+	}
+}
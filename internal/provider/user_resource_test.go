@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserResourceRoleNameConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("strapi_user.test", "username", "tf_role_user"),
+					resource.TestCheckResourceAttr("strapi_user.test", "role_name", "authenticated"),
+					resource.TestCheckResourceAttrPair("strapi_user.test", "role_id", "data.strapi_role.authenticated", "id"),
+				),
+			},
+			{
+				ResourceName:            "strapi_user.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"role_name"},
+			},
+			{
+				Config: testAccUserResourceRoleIDConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("strapi_user.test", "role_name", "Public"),
+					resource.TestCheckResourceAttrPair("strapi_user.test", "role_id", "data.strapi_role.public", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUserResource_password(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserResourcePasswordConfig("FirstPass123!", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("strapi_user.test", "password"),
+					resource.TestCheckResourceAttr("strapi_user.test", "password_version", "1"),
+				),
+			},
+			{
+				Config: testAccUserResourcePasswordConfig("SecondPass123!", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("strapi_user.test", "password"),
+					resource.TestCheckResourceAttr("strapi_user.test", "password_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUserResourcePasswordConfig(password string, version int) string {
+	return fmt.Sprintf(`
+resource "strapi_user" "test" {
+  username         = "tf_password_user"
+  email            = "tf_password_user@example.com"
+  password         = %[1]q
+  password_version = %[2]d
+  confirmed        = true
+}
+`, password, version)
+}
+
+const testAccUserResourceRoleNameConfig = `
+data "strapi_role" "authenticated" {
+  type = "authenticated"
+}
+
+resource "strapi_user" "test" {
+  username  = "tf_role_user"
+  email     = "tf_role_user@example.com"
+  password  = "TestPass123!"
+  confirmed = true
+  role_name = "authenticated"
+}
+`
+
+const testAccUserResourceRoleIDConfig = `
+data "strapi_role" "public" {
+  type = "public"
+}
+
+resource "strapi_user" "test" {
+  username  = "tf_role_user"
+  email     = "tf_role_user@example.com"
+  password  = "TestPass123!"
+  confirmed = true
+  role_id   = tonumber(data.strapi_role.public.id)
+}
+`
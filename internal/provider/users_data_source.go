@@ -0,0 +1,259 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/fbritoferreira/terraform-provider-strapi/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// filterOperators lists the Strapi filter operators supported by the users data source
+var filterOperators = []string{
+	"$eq", "$eqi", "$ne", "$nei", "$lt", "$lte", "$gt", "$gte",
+	"$in", "$notIn", "$contains", "$notContains", "$containsi", "$notContainsi",
+	"$startsWith", "$startsWithi", "$endsWith", "$endsWithi", "$null", "$notNull",
+}
+
+// listFilterOperators lists the filter operators that take a list of values
+var listFilterOperators = map[string]bool{
+	"$in":    true,
+	"$notIn": true,
+}
+
+var _ datasource.DataSource = &UsersDataSource{}
+
+type UsersDataSource struct {
+	client *client.StrapiClient
+}
+
+type UsersDataSourceModel struct {
+	Filters []UserFilterModel     `tfsdk:"filter"`
+	Sort    []types.String        `tfsdk:"sort"`
+	Limit   types.Int64           `tfsdk:"limit"`
+	Users   []UserDataSourceModel `tfsdk:"users"`
+}
+
+type UserFilterModel struct {
+	Field    types.String   `tfsdk:"field"`
+	Operator types.String   `tfsdk:"operator"`
+	Values   []types.String `tfsdk:"values"`
+}
+
+func NewUsersDataSource() datasource.DataSource {
+	return &UsersDataSource{}
+}
+
+func (d *UsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *UsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the Strapi users-permissions users matching a set of filters.",
+		Attributes: map[string]schema.Attribute{
+			"sort": schema.ListAttribute{
+				Description: "The sort order of the users, as a list of '<field>:<asc|desc>' (e.g., 'createdAt:desc').",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"limit": schema.Int64Attribute{
+				Description: "The maximum number of users to return. All matching users are returned when omitted.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"users": schema.ListNestedAttribute{
+				Description: "The users matching the filters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the user.",
+							Computed:    true,
+						},
+						"document_id": schema.StringAttribute{
+							Description: "The document ID of the user.",
+							Computed:    true,
+						},
+						"email": schema.StringAttribute{
+							Description: "The email address of the user.",
+							Computed:    true,
+						},
+						"username": schema.StringAttribute{
+							Description: "The username of the user.",
+							Computed:    true,
+						},
+						"confirmed": schema.BoolAttribute{
+							Description: "Whether the user has confirmed their email.",
+							Computed:    true,
+						},
+						"blocked": schema.BoolAttribute{
+							Description: "Whether the user is blocked.",
+							Computed:    true,
+						},
+						"role_id": schema.Int64Attribute{
+							Description: "The ID of the role of the user.",
+							Computed:    true,
+						},
+						"role_name": schema.StringAttribute{
+							Description: "The name of the role of the user.",
+							Computed:    true,
+						},
+						"role_type": schema.StringAttribute{
+							Description: "The type of the role of the user (e.g., 'authenticated').",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "The creation timestamp of the user.",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "The last update timestamp of the user.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.ListNestedBlock{
+				Description: "Filters the users must match. All filters are combined with a logical AND.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"field": schema.StringAttribute{
+							Description: "The field to filter on. Relations are traversed with dots (e.g., 'email', 'blocked', 'role.name').",
+							Required:    true,
+						},
+						"operator": schema.StringAttribute{
+							Description: "The Strapi filter operator (e.g., '$eq', '$contains', '$in'). Defaults to '$eq'.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(filterOperators...),
+							},
+						},
+						"values": schema.ListAttribute{
+							Description: "The values to compare the field with. Only '$in' and '$notIn' accept several values.",
+							Required:    true,
+							ElementType: types.StringType,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *UsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.StrapiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.StrapiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state UsersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query, err := usersQuery(state.Filters, state.Sort)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid user filter",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Users = []UserDataSourceModel{}
+	for user, err := range d.client.Users(ctx, query) {
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading users",
+				fmt.Sprintf("Could not read users: %s", err),
+			)
+			return
+		}
+
+		state.Users = append(state.Users, flattenUser(user))
+
+		if !state.Limit.IsNull() && int64(len(state.Users)) >= state.Limit.ValueInt64() {
+			break
+		}
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Read %d users", len(state.Users)))
+}
+
+// usersQuery builds the qs-style query string Strapi expects for filters and sort, such as
+// filters[$and][0][role][name][$eq]=Authenticated or filters[$and][1][email][$in][0]=a@example.com. Each filter
+// is its own $and condition so that several filters on the same field and operator do not overwrite each other.
+// The role is always populated.
+func usersQuery(filters []UserFilterModel, sort []types.String) (url.Values, error) {
+	query := url.Values{}
+	query.Set("populate", "role")
+
+	for i, filter := range filters {
+		operator := filter.Operator.ValueString()
+		if operator == "" {
+			operator = "$eq"
+		}
+
+		key := "filters[$and][" + strconv.Itoa(i) + "]"
+		for _, part := range strings.Split(filter.Field.ValueString(), ".") {
+			key += "[" + part + "]"
+		}
+		key += "[" + operator + "]"
+
+		values := stringValues(filter.Values)
+		if listFilterOperators[operator] {
+			for i, value := range values {
+				query.Set(key+"["+strconv.Itoa(i)+"]", value)
+			}
+			continue
+		}
+
+		if len(values) != 1 {
+			return nil, fmt.Errorf("the %s operator on field '%s' takes exactly one value, got %d", operator, filter.Field.ValueString(), len(values))
+		}
+		query.Set(key, values[0])
+	}
+
+	for i, value := range stringValues(sort) {
+		query.Set("sort["+strconv.Itoa(i)+"]", value)
+	}
+
+	return query, nil
+}
@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestUsersQuery(t *testing.T) {
+	filters := []UserFilterModel{
+		{
+			Field:    types.StringValue("blocked"),
+			Operator: types.StringNull(),
+			Values:   []types.String{types.StringValue("true")},
+		},
+		{
+			Field:    types.StringValue("role.name"),
+			Operator: types.StringValue("$containsi"),
+			Values:   []types.String{types.StringValue("editor")},
+		},
+		{
+			Field:    types.StringValue("email"),
+			Operator: types.StringValue("$in"),
+			Values:   []types.String{types.StringValue("a@example.com"), types.StringValue("b@example.com")},
+		},
+		{
+			Field:    types.StringValue("email"),
+			Operator: types.StringValue("$ne"),
+			Values:   []types.String{types.StringValue("c@example.com")},
+		},
+		{
+			Field:    types.StringValue("email"),
+			Operator: types.StringValue("$ne"),
+			Values:   []types.String{types.StringValue("d@example.com")},
+		},
+	}
+	sort := []types.String{types.StringValue("email:asc"), types.StringValue("createdAt:desc")}
+
+	query, err := usersQuery(filters, sort)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]string{
+		"populate":                                 "role",
+		"filters[$and][0][blocked][$eq]":           "true",
+		"filters[$and][1][role][name][$containsi]": "editor",
+		"filters[$and][2][email][$in][0]":          "a@example.com",
+		"filters[$and][2][email][$in][1]":          "b@example.com",
+		"filters[$and][3][email][$ne]":             "c@example.com",
+		"filters[$and][4][email][$ne]":             "d@example.com",
+		"sort[0]":                                  "email:asc",
+		"sort[1]":                                  "createdAt:desc",
+	}
+	if len(query) != len(expected) {
+		t.Errorf("expected %d query parameters, got %d: %v", len(expected), len(query), query)
+	}
+	for key, value := range expected {
+		if got := query.Get(key); got != value {
+			t.Errorf("expected %s=%q, got %q", key, value, got)
+		}
+	}
+}
+
+func TestUsersQueryRejectsSeveralValues(t *testing.T) {
+	filters := []UserFilterModel{
+		{
+			Field:    types.StringValue("email"),
+			Operator: types.StringValue("$eq"),
+			Values:   []types.String{types.StringValue("a@example.com"), types.StringValue("b@example.com")},
+		},
+	}
+
+	if _, err := usersQuery(filters, nil); err == nil {
+		t.Fatal("expected an error for several values with $eq")
+	}
+}
+
+func TestAccUsersDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsersDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.strapi_users.blocked", "users.#", "1"),
+					resource.TestCheckResourceAttrPair("data.strapi_users.blocked", "users.0.id", "strapi_user.blocked", "id"),
+					resource.TestCheckResourceAttr("data.strapi_users.blocked", "users.0.blocked", "true"),
+					resource.TestCheckResourceAttr("data.strapi_users.blocked", "users.0.role_name", "Authenticated"),
+					resource.TestCheckResourceAttr("data.strapi_users.sorted", "users.#", "2"),
+					resource.TestCheckResourceAttr("data.strapi_users.sorted", "users.0.username", "tf_list_user_b"),
+					resource.TestCheckResourceAttr("data.strapi_users.sorted", "users.1.username", "tf_list_user_a"),
+					resource.TestCheckResourceAttr("data.strapi_users.limited", "users.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccUsersDataSourceConfig = `
+resource "strapi_user" "active" {
+  username  = "tf_list_user_a"
+  email     = "tf_list_user_a@example.com"
+  password  = "TestPass123!"
+  confirmed = true
+  role_name = "Authenticated"
+}
+
+resource "strapi_user" "blocked" {
+  username  = "tf_list_user_b"
+  email     = "tf_list_user_b@example.com"
+  password  = "TestPass123!"
+  confirmed = true
+  blocked   = true
+  role_name = "Authenticated"
+}
+
+data "strapi_users" "blocked" {
+  filter {
+    field  = "blocked"
+    values = ["true"]
+  }
+
+  filter {
+    field    = "email"
+    operator = "$in"
+    values   = [strapi_user.active.email, strapi_user.blocked.email]
+  }
+}
+
+data "strapi_users" "sorted" {
+  filter {
+    field    = "username"
+    operator = "$startsWith"
+    values   = ["tf_list_user_"]
+  }
+
+  filter {
+    field  = "role.name"
+    values = ["Authenticated"]
+  }
+
+  sort = ["username:desc"]
+
+  depends_on = [strapi_user.active, strapi_user.blocked]
+}
+
+data "strapi_users" "limited" {
+  filter {
+    field    = "username"
+    operator = "$startsWith"
+    values   = ["tf_list_user_"]
+  }
+
+  limit = 1
+
+  depends_on = [strapi_user.active, strapi_user.blocked]
+}
+`
@@ -0,0 +1,291 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fbritoferreira/terraform-provider-strapi/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &WebhookResource{}
+var _ resource.ResourceWithImportState = &WebhookResource{}
+
+type WebhookResource struct {
+	client *client.StrapiClient
+}
+
+type WebhookResourceModel struct {
+	ID      types.String            `tfsdk:"id"`
+	Name    types.String            `tfsdk:"name"`
+	URL     types.String            `tfsdk:"url"`
+	Headers map[string]types.String `tfsdk:"headers"`
+	Events  []types.String          `tfsdk:"events"`
+	Enabled types.Bool              `tfsdk:"enabled"`
+}
+
+func NewWebhookResource() resource.Resource {
+	return &WebhookResource{}
+}
+
+func (r *WebhookResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook"
+}
+
+func (r *WebhookResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Strapi webhook, which notifies an external URL when content or media events happen.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the webhook.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the webhook.",
+			},
+			"url": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The URL the webhook sends its POST requests to.",
+			},
+			"headers": schema.MapAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The headers sent with every webhook request (e.g., an authorization header).",
+			},
+			"events": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The events that trigger the webhook (e.g., 'entry.create', 'entry.publish', 'media.update').",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether the webhook is enabled. Defaults to true.",
+			},
+		},
+	}
+}
+
+func (r *WebhookResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.StrapiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.StrapiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *WebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan WebhookResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhook := expandWebhook(plan)
+
+	created, err := r.client.CreateWebhook(ctx, webhook)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating webhook",
+			fmt.Sprintf("Could not create webhook: %s", err),
+		)
+		return
+	}
+
+	if !webhook.IsEnabled {
+		_, err = r.client.UpdateWebhook(ctx, created.ID, webhook)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error disabling webhook",
+				fmt.Sprintf("Could not disable webhook: %s", err),
+			)
+			return
+		}
+	}
+
+	plan.ID = types.StringValue(strconv.Itoa(created.ID))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Created webhook with ID: %d", created.ID))
+}
+
+func (r *WebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state WebhookResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing webhook ID",
+			fmt.Sprintf("Could not parse webhook ID '%s': %s", state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	webhook, err := r.client.GetWebhook(ctx, id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Webhook with ID %d not found, removing from state", id))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading webhook",
+			fmt.Sprintf("Could not read webhook: %s", err),
+		)
+		return
+	}
+
+	state.Name = types.StringValue(webhook.Name)
+	state.URL = types.StringValue(webhook.URL)
+	state.Headers = flattenWebhookHeaders(webhook.Headers, state.Headers)
+	state.Events = optionalStringValues(webhook.Events, state.Events)
+	state.Enabled = types.BoolValue(webhook.IsEnabled)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Read webhook with ID: %d", id))
+}
+
+func (r *WebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan WebhookResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing webhook ID",
+			fmt.Sprintf("Could not parse webhook ID '%s': %s", plan.ID.ValueString(), err),
+		)
+		return
+	}
+
+	_, err = r.client.UpdateWebhook(ctx, id, expandWebhook(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating webhook",
+			fmt.Sprintf("Could not update webhook: %s", err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Updated webhook with ID: %d", id))
+}
+
+func (r *WebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state WebhookResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing webhook ID",
+			fmt.Sprintf("Could not parse webhook ID '%s': %s", state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	err = r.client.DeleteWebhook(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting webhook",
+			fmt.Sprintf("Could not delete webhook: %s", err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Deleted webhook with ID: %d", id))
+}
+
+func (r *WebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func expandWebhook(plan WebhookResourceModel) client.Webhook {
+	var headers map[string]string
+	if plan.Headers != nil {
+		headers = make(map[string]string, len(plan.Headers))
+		for name, value := range plan.Headers {
+			headers[name] = value.ValueString()
+		}
+	}
+
+	return client.Webhook{
+		Name:      plan.Name.ValueString(),
+		URL:       plan.URL.ValueString(),
+		Headers:   headers,
+		Events:    stringValues(plan.Events),
+		IsEnabled: plan.Enabled.ValueBool(),
+	}
+}
+
+// flattenWebhookHeaders converts the headers of a webhook into their Terraform representation.
+// An empty set of headers is represented as null when the headers were not set before.
+func flattenWebhookHeaders(headers map[string]string, prior map[string]types.String) map[string]types.String {
+	if len(headers) == 0 && prior == nil {
+		return nil
+	}
+
+	result := make(map[string]types.String, len(headers))
+	for name, value := range headers {
+		result[name] = types.StringValue(value)
+	}
+	return result
+}
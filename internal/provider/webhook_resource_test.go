@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccWebhookResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebhookResourceConfig(`["entry.create", "entry.update"]`, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("strapi_webhook.test", "name", "Terraform Cache Purge"),
+					resource.TestCheckResourceAttr("strapi_webhook.test", "url", "https://example.com/hooks/strapi"),
+					resource.TestCheckResourceAttr("strapi_webhook.test", "headers.Authorization", "Bearer secret"),
+					resource.TestCheckResourceAttr("strapi_webhook.test", "events.#", "2"),
+					resource.TestCheckResourceAttr("strapi_webhook.test", "enabled", "true"),
+					resource.TestCheckResourceAttrSet("strapi_webhook.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "strapi_webhook.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccWebhookResourceConfig(`["entry.publish", "media.update"]`, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("strapi_webhook.test", "events.*", "media.update"),
+					resource.TestCheckResourceAttr("strapi_webhook.test", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWebhookResourceConfig(events string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "strapi_webhook" "test" {
+  name    = "Terraform Cache Purge"
+  url     = "https://example.com/hooks/strapi"
+  events  = %[1]s
+  enabled = %[2]t
+
+  headers = {
+    Authorization = "Bearer secret"
+  }
+}
+`, events, enabled)
+}